@@ -0,0 +1,318 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/config"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/fieldcrypt"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/migrations"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/storage/postgres"
+	"gopkg.in/yaml.v3"
+)
+
+const usage = `usage: adminctl [-addr URL] [-token TOKEN] <command> [flags]
+
+commands:
+  apply -f FILE [-dry-run]   apply declarative team configuration from JSON, or
+                             YAML if the name ends in .yaml or .yml
+  migrate [status|up [-contract]]
+                             show or apply database migrations (uses DATABASE_URL);
+                             contract migrations are applied only with -contract
+  consistency                run data invariant checks (requires -token), exit 1 on violations
+  import-legacy -f FILE [-dry-run]
+                             validate and load legacy teams and pull requests from
+                             JSON or CSV (requires -token), exit 1 on validation
+                             issues or conflicts with existing users
+  encrypt-pii [-batch N]     encrypt plaintext usernames and re-wrap values sealed with
+                             older keys (uses DATABASE_URL and PII_ENCRYPTION_KEYS)
+`
+
+func main() {
+	log.SetFlags(0)
+
+	addr := flag.String("addr", getEnv("ADMINCTL_ADDR", "http://localhost:8080"), "service base URL")
+	token := flag.String("token", getEnv("ADMINCTL_TOKEN", ""), "trusted caller token")
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	var err error
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "apply":
+		err = runApply(client, *addr, args)
+	case "migrate":
+		err = runMigrate(args)
+	case "consistency":
+		err = runConsistency(client, *addr, *token)
+	case "import-legacy":
+		err = runImportLegacy(client, *addr, *token, args)
+	case "encrypt-pii":
+		err = runEncryptPII(args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("adminctl: %v", err)
+	}
+}
+
+func runApply(client *http.Client, addr string, args []string) error {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+	file := fs.String("f", "", "path to team configuration (JSON, or YAML if the name ends in .yaml or .yml)")
+	dryRun := fs.Bool("dry-run", false, "only print the plan")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return fmt.Errorf("apply: -f is required")
+	}
+
+	body, err := os.ReadFile(*file)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", *file, err)
+	}
+	switch strings.ToLower(filepath.Ext(*file)) {
+	case ".yaml", ".yml":
+		if body, err = yamlToJSON(body); err != nil {
+			return fmt.Errorf("parse %s: %w", *file, err)
+		}
+	}
+
+	query := url.Values{}
+	if *dryRun {
+		query.Set("dry_run", "true")
+	}
+
+	_, err = post(client, addr+"/team/apply?"+query.Encode(), "", "application/json", body)
+	return err
+}
+
+// yamlToJSON re-encodes a YAML document as JSON. Keys are passed through
+// unchanged, so the service rejects unknown ones exactly as it does for JSON.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+func runImportLegacy(client *http.Client, addr, token string, args []string) error {
+	fs := flag.NewFlagSet("import-legacy", flag.ExitOnError)
+	file := fs.String("f", "", "path to legacy export (JSON, or CSV if the name ends in .csv)")
+	dryRun := fs.Bool("dry-run", false, "only validate and print the report")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return fmt.Errorf("import-legacy: -f is required")
+	}
+	if token == "" {
+		return fmt.Errorf("import-legacy: -token or ADMINCTL_TOKEN is required")
+	}
+
+	body, err := os.ReadFile(*file)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", *file, err)
+	}
+
+	query := url.Values{}
+	if *dryRun {
+		query.Set("dry_run", "true")
+	}
+
+	contentType := "application/json"
+	if strings.EqualFold(filepath.Ext(*file), ".csv") {
+		contentType = "text/csv"
+	}
+
+	resp, err := post(client, addr+"/admin/importLegacy?"+query.Encode(), token, contentType, body)
+	if err != nil {
+		return err
+	}
+
+	var report struct {
+		Issues    []json.RawMessage `json:"issues"`
+		Conflicts []json.RawMessage `json:"conflicts"`
+	}
+	if err := json.Unmarshal(resp, &report); err != nil {
+		return fmt.Errorf("decode import report: %w", err)
+	}
+	if len(report.Issues)+len(report.Conflicts) > 0 {
+		return fmt.Errorf("%d import issue(s) and %d conflict(s) found, nothing was imported", len(report.Issues), len(report.Conflicts))
+	}
+	return nil
+}
+
+func runMigrate(args []string) error {
+	ctx := context.Background()
+
+	action := "status"
+	if len(args) > 0 {
+		action, args = args[0], args[1:]
+	}
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	contract := fs.Bool("contract", false, "also apply contract migrations")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	databaseURL, err := config.DefaultSecretResolver().Resolve(ctx, "DATABASE_URL", "")
+	if err != nil {
+		return err
+	}
+	if databaseURL == "" {
+		return fmt.Errorf("migrate: DATABASE_URL is required")
+	}
+
+	switch action {
+	case "status":
+	case "up":
+		if err := migrations.Run(ctx, databaseURL, time.Minute, *contract, nil); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("migrate: unknown action %q", action)
+	}
+
+	status, err := migrations.GetStatus(ctx, databaseURL)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("schema version: %d\nexpected version: %d\nlatest version: %d\ndirty: %t\n", status.Current, status.Expected, status.Latest, status.Dirty)
+	return nil
+}
+
+func runEncryptPII(args []string) error {
+	ctx := context.Background()
+
+	fs := flag.NewFlagSet("encrypt-pii", flag.ExitOnError)
+	batch := fs.Int("batch", 500, "rows per transaction")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *batch < 1 {
+		return fmt.Errorf("encrypt-pii: -batch must be positive")
+	}
+
+	secrets := config.DefaultSecretResolver()
+	databaseURL, err := secrets.Resolve(ctx, "DATABASE_URL", "")
+	if err != nil {
+		return err
+	}
+	if databaseURL == "" {
+		return fmt.Errorf("encrypt-pii: DATABASE_URL is required")
+	}
+	spec, err := secrets.Resolve(ctx, "PII_ENCRYPTION_KEYS", "")
+	if err != nil {
+		return err
+	}
+	keys, err := fieldcrypt.ParseKeyring(spec)
+	if err != nil {
+		return fmt.Errorf("parse PII_ENCRYPTION_KEYS: %w", err)
+	}
+	if keys == nil {
+		return fmt.Errorf("encrypt-pii: PII_ENCRYPTION_KEYS is required")
+	}
+
+	db, err := postgres.New(ctx, databaseURL, nil, postgres.Options{Component: "adminctl"})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	rewritten, err := repository.New(db, nil, 0, repository.Compat{}, keys).ReencryptUsernames(ctx, *batch)
+	fmt.Printf("usernames rewritten: %d\n", rewritten)
+	return err
+}
+
+func runConsistency(client *http.Client, addr, token string) error {
+	if token == "" {
+		return fmt.Errorf("consistency: -token or ADMINCTL_TOKEN is required")
+	}
+	req, err := http.NewRequest(http.MethodGet, addr+"/admin/consistency", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	os.Stdout.Write(body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var report struct {
+		Violations []json.RawMessage `json:"violations"`
+	}
+	if err := json.Unmarshal(body, &report); err != nil {
+		return fmt.Errorf("decode consistency report: %w", err)
+	}
+	if len(report.Violations) > 0 {
+		return fmt.Errorf("%d consistency violation(s) found", len(report.Violations))
+	}
+	return nil
+}
+
+func post(client *http.Client, target, token, contentType string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	os.Stdout.Write(respBody)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return respBody, nil
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
@@ -2,28 +2,64 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
+	"os"
+	_ "time/tzdata"
 
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/app"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/buildinfo"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/config"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/logger"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/service"
 	"go.uber.org/zap"
 )
 
 func main() {
+	check := flag.Bool("check", false, "validate config, database and migrations, then exit")
+	flag.Parse()
+
 	ctx := context.Background()
 
 	cfg, err := config.Load()
 	if err != nil {
+		if *check {
+			fmt.Printf("FAIL config: %v\n", err)
+			os.Exit(1)
+		}
 		log.Fatalf("config: %v", err)
 	}
 
-	zapLogger, err := logger.New(cfg.LogLevel)
+	if *check {
+		os.Exit(runCheck(ctx, cfg))
+	}
+
+	zapLogger, err := logger.New(logger.Options{
+		Level:              cfg.LogLevel,
+		PIIMode:            cfg.LogPII,
+		PIIKey:             cfg.LogPIIKey,
+		PIIKeys:            service.PIILogKeys,
+		Format:             cfg.LogFormat,
+		SamplingInitial:    cfg.LogSampleInitial,
+		SamplingThereafter: cfg.LogSampleAfter,
+		LevelOverrides:     cfg.LogLevels,
+	})
 	if err != nil {
 		log.Fatalf("logger: %v", err)
 	}
 	defer zapLogger.Sync()
 
+	info := buildinfo.Get()
+	zapLogger.Info("starting pr-reviewer",
+		zap.String("version", info.Version),
+		zap.String("commit", info.Commit),
+		zap.String("build_time", info.BuildTime),
+		zap.String("go_version", info.GoVersion),
+		zap.String("region", cfg.Region),
+		zap.String("replica_role", string(cfg.ReplicaRole)),
+	)
+
 	application, err := app.New(ctx, cfg, zapLogger)
 	if err != nil {
 		zapLogger.Fatal("init app failed", zap.Error(err))
@@ -33,3 +69,16 @@ func main() {
 		zapLogger.Fatal("app stopped", zap.Error(err))
 	}
 }
+
+func runCheck(ctx context.Context, cfg config.Config) int {
+	code := 0
+	for _, result := range app.Check(ctx, cfg) {
+		status := "OK  "
+		if !result.OK {
+			status = "FAIL"
+			code = 1
+		}
+		fmt.Printf("%s %s: %s\n", status, result.Name, result.Detail)
+	}
+	return code
+}
@@ -3,11 +3,20 @@ package app
 import (
 	"context"
 	"os/signal"
+	"slices"
 	"syscall"
+	"time"
 
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/config"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/fieldcrypt"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/health"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpclient"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpserver"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/icalendar"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/jobs"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/migrations"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/objectstore"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/replica"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/service"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/storage/postgres"
@@ -22,22 +31,205 @@ type App struct {
 	db         *pgxpool.Pool
 	repo       *repository.Repository
 	svc        *service.Service
+	lifecycle  *lifecycle
 }
 
-func New(ctx context.Context, cfg config.Config, logger *zap.Logger) (*App, error) {
-	db, err := postgres.New(ctx, cfg.DatabaseURL, logger)
+func New(ctx context.Context, cfg config.Config, logger *zap.Logger) (_ *App, err error) {
+	// Pools join the lifecycle as soon as they open, so a failed New releases
+	// them through the deferred shutdown.
+	lc := newLifecycle(logger)
+	defer func() {
+		if err != nil {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+			defer cancel()
+			_ = lc.shutdown(shutdownCtx)
+		}
+	}()
+
+	queryDurations := postgres.NewQueryHistogram()
+	db, err := postgres.New(ctx, cfg.DatabaseURL, logger.Named("postgres"), postgres.Options{
+		RefreshDSN:         cfg.ResolveDatabaseURL,
+		SlowQueryThreshold: cfg.SlowQueryThreshold,
+		QueryHistogram:     queryDurations,
+	})
+	if err != nil {
+		return nil, err
+	}
+	lc.add("postgres", nil, func(ctx context.Context) error {
+		return closeWithContext(ctx, db.Close)
+	})
+
+	if cfg.MigrateOnStart && cfg.ReplicaRole == replica.RolePrimary {
+		err = migrations.Run(ctx, cfg.DatabaseURL, cfg.MigrationLock, cfg.MigrateContract, logger.Named("migrations"))
+	} else {
+		err = migrations.VerifyCompatible(ctx, cfg.DatabaseURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var readDB *pgxpool.Pool
+	if cfg.DatabaseReadURL != "" {
+		readDB, err = postgres.New(ctx, cfg.DatabaseReadURL, logger.Named("postgres-read"), postgres.Options{
+			Component:          "read",
+			RefreshDSN:         cfg.ResolveDatabaseReadURL,
+			SlowQueryThreshold: cfg.SlowQueryThreshold,
+			QueryHistogram:     queryDurations,
+		})
+		if err != nil {
+			return nil, err
+		}
+		lc.add("postgres-read", nil, func(ctx context.Context) error {
+			return closeWithContext(ctx, readDB.Close)
+		})
+	}
+
+	piiKeys, err := fieldcrypt.ParseKeyring(cfg.PIIEncryptionKeys)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := migrations.Run(ctx, cfg.DatabaseURL, logger); err != nil {
-		db.Close()
+	rotating := newSecrets(cfg)
+	replicaState := replica.NewState(cfg.Region, cfg.ReplicaRole)
+	repo := repository.New(db, readDB, cfg.DBAcquireTimeout, repository.Compat{
+		DualReadPullRequestTitle: cfg.DualReadPullRequestTitle,
+	}, piiKeys)
+	outbound, err := httpclient.NewRegistry(rotating.outboundProxy.Value)
+	if err != nil {
 		return nil, err
 	}
+	var absenceSource service.AbsenceSource
+	if cfg.AbsenceCalendarURL != "" {
+		absenceSource = icalendar.NewFeed(rotating.absenceCalendarURL.Value, outbound.Client("absence_calendar", httpclient.Config{
+			Timeout:    30 * time.Second,
+			MaxRetries: cfg.OutboundRetries,
+		}))
+	}
+	var archiveStore service.ArchiveStore
+	if cfg.ExportS3Bucket != "" {
+		archiveStore, err = objectstore.NewS3(objectstore.S3Config{
+			Endpoint:    cfg.ExportS3Endpoint,
+			Region:      cfg.ExportS3Region,
+			Bucket:      cfg.ExportS3Bucket,
+			Prefix:      cfg.ExportS3Prefix,
+			Credentials: rotating.exportCredentials,
+		}, outbound.Client("export_s3", httpclient.Config{
+			Timeout:    5 * time.Minute,
+			MaxRetries: cfg.OutboundRetries,
+		}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	svc := service.New(repo, logger.Named("service"), service.Config{
+		ShadowAssignment:   cfg.ShadowAssignment,
+		AssignmentStrategy: cfg.AssignmentStrategy,
+		SnoozeBudget:       cfg.SnoozeBudget,
+		DefaultCalendar:    cfg.DefaultCalendar,
+		ReviewSLA:          cfg.ReviewSLA,
+		LongPollMaxWait:    cfg.LongPollMaxWait,
+		MinTeamMembers:     cfg.MinTeamMembers,
+		MaxTeamMembers:     cfg.MaxTeamMembers,
+		MinTZOverlap:       cfg.MinTimezoneOverlap,
+		MinApprovals:       cfg.MergeMinApprovals,
 
-	repo := repository.New(db)
-	svc := service.New(repo)
-	server := httpserver.New(cfg.HTTPPort, logger, svc)
+		ReassignDedupeWindow:     cfg.ReassignDedupeWindow,
+		RequireUUIDPullRequestID: cfg.RequireUUIDPullRequestID,
+		RequireDeclineReason:     cfg.RequireDeclineReason,
+		AuthorDailyPullRequests:  cfg.AuthorDailyPullRequests,
+		MemberSnapshotTTL:        cfg.MemberSnapshotTTL,
+		PullRequestCacheTTL:      cfg.PullRequestCacheTTL,
+		SyncRetention:            cfg.SyncRetention,
+		PIIMode:                  cfg.LogPII,
+		PIIKey:                   cfg.LogPIIKey,
+
+		AbsenceSource: absenceSource,
+		ArchiveStore:  archiveStore,
+	})
+	if role, err := svc.Admin.GetReplicaRole(ctx, cfg.Region); err != nil {
+		logger.Warn("load persisted replica role failed, using REPLICA_ROLE", zap.Error(err))
+	} else if role != "" {
+		persisted, err := replica.ParseRole(role)
+		if err != nil {
+			return nil, err
+		}
+		replicaState.SetRole(persisted)
+	}
+	readiness := health.NewRegistry(cfg.ReadyProbeTimeout, cfg.ReadyCritical)
+	readiness.Register("postgres", db.Ping)
+	if readDB != nil {
+		readiness.Register("postgres-read", readDB.Ping)
+	}
+	if p, ok := absenceSource.(health.Pinger); ok {
+		readiness.Register("absence-calendar", p.Ping)
+	}
+	if p, ok := archiveStore.(health.Pinger); ok {
+		readiness.Register("archive", p.Ping)
+	}
+	for _, source := range cfg.Secrets.Sources() {
+		if p, ok := source.(health.Pinger); ok {
+			readiness.Register(source.Name(), p.Ping)
+		}
+	}
+	for _, name := range cfg.ReadyCritical {
+		if !slices.Contains(readiness.Names(), name) {
+			logger.Warn("unknown critical dependency in READY_CRITICAL_DEPENDENCIES", zap.String("dependency", name))
+		}
+	}
+
+	server := httpserver.New(httpserver.Config{
+		Port:               cfg.HTTPPort,
+		TrustedCallerToken: rotating.trustedCallerToken.Value,
+		Replica:            replicaState,
+		ReadConsistency:    cfg.ReadConsistency,
+		Health:             readiness,
+		Outbound:           outbound,
+		QueryDurations:     queryDurations,
+		LoadShed: httpserver.LoadShedConfig{
+			MaxInFlight:       cfg.ShedMaxInFlight,
+			MaxAcquireLatency: cfg.ShedMaxAcquireLatency,
+			RetryAfter:        cfg.ShedRetryAfter,
+			AcquireLatency:    postgres.NewAcquireMonitor(db).Latency,
+		},
+	}, logger.Named("httpserver"), svc)
+
+	snoozeWaker := jobs.NewPeriodic("snooze-waker", cfg.SnoozeWakeInterval, logger.Named("jobs"), primaryOnly(replicaState, svc.PullRequests.WakeSnoozedReviews))
+	lc.add(snoozeWaker.Name(), snoozeWaker.Run, snoozeWaker.Stop)
+	freezeLifter := jobs.NewPeriodic("freeze-lifter", cfg.FreezeLiftInterval, logger.Named("jobs"), primaryOnly(replicaState, svc.PullRequests.AssignDeferredReviews))
+	lc.add(freezeLifter.Name(), freezeLifter.Run, freezeLifter.Stop)
+	if cfg.DBMaintenanceInterval > 0 {
+		maintenance := jobs.NewPeriodic("db-maintenance", cfg.DBMaintenanceInterval, logger.Named("jobs"), primaryOnly(replicaState, svc.Admin.RunMaintenance))
+		lc.add(maintenance.Name(), maintenance.Run, maintenance.Stop)
+	}
+	if cfg.InvariantsInterval > 0 {
+		invariants := jobs.NewPeriodic("invariants", cfg.InvariantsInterval, logger.Named("jobs"), svc.Admin.CollectInvariants)
+		lc.add(invariants.Name(), invariants.Run, invariants.Stop)
+	}
+	if cfg.StatsRefreshInterval > 0 {
+		statsViews := jobs.NewPeriodic("stats-views", cfg.StatsRefreshInterval, logger.Named("jobs"), primaryOnly(replicaState, svc.Stats.RefreshStatsViews))
+		lc.add(statsViews.Name(), statsViews.Run, statsViews.Stop)
+	}
+	if cfg.AgingSnapshotInterval > 0 {
+		agingSnapshots := jobs.NewPeriodic("aging-snapshots", cfg.AgingSnapshotInterval, logger.Named("jobs"), primaryOnly(replicaState, svc.Stats.SnapshotPullRequestAging))
+		lc.add(agingSnapshots.Name(), agingSnapshots.Run, agingSnapshots.Stop)
+	}
+	if cfg.SyncCompactInterval > 0 {
+		syncCompaction := jobs.NewPeriodic("sync-compaction", cfg.SyncCompactInterval, logger.Named("jobs"), primaryOnly(replicaState, svc.Admin.CompactSyncChanges))
+		lc.add(syncCompaction.Name(), syncCompaction.Run, syncCompaction.Stop)
+	}
+	if absenceSource != nil && cfg.AbsenceSyncInterval > 0 {
+		absenceSync := jobs.NewPeriodic("absence-sync", cfg.AbsenceSyncInterval, logger.Named("jobs"), primaryOnly(replicaState, svc.Admin.SyncAbsences))
+		lc.add(absenceSync.Name(), absenceSync.Run, absenceSync.Stop)
+	}
+	if archiveStore != nil && cfg.ExportInterval > 0 {
+		historyExport := jobs.NewPeriodic("history-export", cfg.ExportInterval, logger.Named("jobs"), primaryOnly(replicaState, svc.Admin.ExportHistory))
+		lc.add(historyExport.Name(), historyExport.Run, historyExport.Stop)
+	}
+	if cfg.SecretRefreshInterval > 0 {
+		secretRefresh := jobs.NewPeriodic("secret-refresh", cfg.SecretRefreshInterval, logger.Named("jobs"), rotating.refresh(piiKeys, logger.Named("secrets")))
+		lc.add(secretRefresh.Name(), secretRefresh.Run, secretRefresh.Stop)
+	}
+	lc.add("http", server.Start, server.Stop)
 
 	return &App{
 		cfg:        cfg,
@@ -46,30 +238,22 @@ func New(ctx context.Context, cfg config.Config, logger *zap.Logger) (*App, erro
 		db:         db,
 		repo:       repo,
 		svc:        svc,
+		lifecycle:  lc,
 	}, nil
 }
 
 func (a *App) Run(ctx context.Context) error {
-	defer a.db.Close()
 	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	errCh := make(chan error, 1)
-	go func() {
-		errCh <- a.httpServer.Start()
-	}()
-
-	select {
-	case <-ctx.Done():
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.cfg.ShutdownTimeout)
-		defer cancel()
+	return a.lifecycle.run(ctx, a.cfg.ShutdownTimeout)
+}
 
-		if err := a.httpServer.Stop(shutdownCtx); err != nil {
-			return err
+func primaryOnly(state *replica.State, fn func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if !state.Writable() {
+			return nil
 		}
-
-		return <-errCh
-	case err := <-errCh:
-		return err
+		return fn(ctx)
 	}
 }
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/config"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/migrations"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/storage/postgres"
+)
+
+type CheckResult struct {
+	Name   string
+	OK     bool
+	Detail string
+}
+
+func Check(ctx context.Context, cfg config.Config) []CheckResult {
+	results := []CheckResult{
+		{Name: "config", OK: true, Detail: fmt.Sprintf("http port %s, log level %s", cfg.HTTPPort, cfg.LogLevel)},
+	}
+
+	db, err := postgres.New(ctx, cfg.DatabaseURL, nil, postgres.Options{Component: "check"})
+	if err != nil {
+		return append(results,
+			CheckResult{Name: "postgres", Detail: err.Error()},
+			CheckResult{Name: "migrations", Detail: "skipped: postgres is unreachable"},
+		)
+	}
+	db.Close()
+	results = append(results, CheckResult{Name: "postgres", OK: true, Detail: "connected"})
+
+	status, err := migrations.GetStatus(ctx, cfg.DatabaseURL)
+	switch {
+	case err != nil:
+		results = append(results, CheckResult{Name: "migrations", Detail: err.Error()})
+	case status.Dirty:
+		results = append(results, CheckResult{Name: "migrations", Detail: fmt.Sprintf("schema version %d is dirty", status.Current)})
+	default:
+		results = append(results, CheckResult{
+			Name:   "migrations",
+			OK:     cfg.MigrateOnStart || status.Current >= status.Expected,
+			Detail: fmt.Sprintf("schema version %d, binary expects %d", status.Current, status.Expected),
+		})
+	}
+
+	return results
+}
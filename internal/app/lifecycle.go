@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type component struct {
+	name string
+	run  func() error
+	stop func(context.Context) error
+}
+
+type lifecycle struct {
+	logger     *zap.Logger
+	components []component
+}
+
+func newLifecycle(logger *zap.Logger) *lifecycle {
+	return &lifecycle{logger: logger}
+}
+
+func (l *lifecycle) add(name string, run func() error, stop func(context.Context) error) {
+	l.components = append(l.components, component{name: name, run: run, stop: stop})
+}
+
+func (l *lifecycle) run(ctx context.Context, shutdownTimeout time.Duration) error {
+	errCh := make(chan error, len(l.components))
+	for _, c := range l.components {
+		if c.run == nil {
+			continue
+		}
+		go func(c component) {
+			if err := c.run(); err != nil {
+				errCh <- fmt.Errorf("%s: %w", c.name, err)
+			}
+		}(c)
+	}
+
+	var runErr error
+	select {
+	case <-ctx.Done():
+		l.logger.Info("shutdown requested")
+	case runErr = <-errCh:
+		l.logger.Error("component failed, shutting down", zap.Error(runErr))
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return errors.Join(runErr, l.shutdown(shutdownCtx))
+}
+
+func (l *lifecycle) shutdown(ctx context.Context) error {
+	var errs []error
+	for i := len(l.components) - 1; i >= 0; i-- {
+		c := l.components[i]
+		if c.stop == nil {
+			continue
+		}
+
+		start := time.Now()
+		if err := c.stop(ctx); err != nil {
+			l.logger.Error("component stop failed",
+				zap.String("component", c.name),
+				zap.Duration("duration", time.Since(start)),
+				zap.Error(err),
+			)
+			errs = append(errs, fmt.Errorf("stop %s: %w", c.name, err))
+			continue
+		}
+		l.logger.Info("component stopped",
+			zap.String("component", c.name),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
+	return errors.Join(errs...)
+}
+
+func closeWithContext(ctx context.Context, closeFn func()) error {
+	done := make(chan struct{})
+	go func() {
+		closeFn()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
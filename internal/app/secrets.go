@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/config"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/fieldcrypt"
+	"go.uber.org/zap"
+)
+
+type secrets struct {
+	trustedCallerToken    *config.RefreshedSecret
+	piiEncryptionKeys     *config.RefreshedSecret
+	outboundProxy         *config.RefreshedSecret
+	absenceCalendarURL    *config.RefreshedSecret
+	exportAccessKeyID     *config.RefreshedSecret
+	exportSecretAccessKey *config.RefreshedSecret
+}
+
+func newSecrets(cfg config.Config) *secrets {
+	return &secrets{
+		trustedCallerToken:    config.NewRefreshedSecret("TRUSTED_CALLER_TOKEN", cfg.TrustedCallerToken, cfg.ResolveTrustedCallerToken),
+		piiEncryptionKeys:     config.NewRefreshedSecret("PII_ENCRYPTION_KEYS", cfg.PIIEncryptionKeys, cfg.ResolvePIIEncryptionKeys),
+		outboundProxy:         config.NewRefreshedSecret("OUTBOUND_HTTP_PROXY", cfg.OutboundProxy, cfg.ResolveOutboundProxy),
+		absenceCalendarURL:    config.NewRefreshedSecret("ABSENCE_CALENDAR_URL", cfg.AbsenceCalendarURL, cfg.ResolveAbsenceCalendarURL),
+		exportAccessKeyID:     config.NewRefreshedSecret("EXPORT_S3_ACCESS_KEY_ID", cfg.ExportS3AccessKeyID, cfg.ResolveExportS3AccessKeyID),
+		exportSecretAccessKey: config.NewRefreshedSecret("EXPORT_S3_SECRET_ACCESS_KEY", cfg.ExportS3SecretAccessKey, cfg.ResolveExportS3SecretAccessKey),
+	}
+}
+
+func (s *secrets) exportCredentials() (string, string) {
+	return s.exportAccessKeyID.Value(), s.exportSecretAccessKey.Value()
+}
+
+func (s *secrets) refresh(keyring *fieldcrypt.Keyring, logger *zap.Logger) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for _, secret := range []*config.RefreshedSecret{
+			s.trustedCallerToken,
+			s.piiEncryptionKeys,
+			s.outboundProxy,
+			s.absenceCalendarURL,
+			s.exportAccessKeyID,
+			s.exportSecretAccessKey,
+		} {
+			changed, err := secret.Refresh(ctx)
+			if err != nil {
+				logger.Warn("refresh secret failed", zap.String("secret", secret.Name()), zap.Error(err))
+				continue
+			}
+			if !changed {
+				continue
+			}
+			logger.Info("secret rotated", zap.String("secret", secret.Name()))
+
+			if secret == s.piiEncryptionKeys && keyring != nil {
+				if err := keyring.Reload(secret.Value()); err != nil {
+					logger.Error("reload PII encryption keys failed, keeping previous keys", zap.Error(err))
+				}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,192 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type SecretSource interface {
+	Name() string
+	Lookup(ctx context.Context, key string) (string, bool, error)
+}
+
+type SecretResolver struct {
+	sources []SecretSource
+}
+
+func NewSecretResolver(sources ...SecretSource) *SecretResolver {
+	return &SecretResolver{sources: sources}
+}
+
+func DefaultSecretResolver() *SecretResolver {
+	sources := []SecretSource{FileSource{}}
+	if addr := os.Getenv("VAULT_ADDR"); addr != "" {
+		sources = append(sources, &VaultSource{
+			Addr:   strings.TrimRight(addr, "/"),
+			Token:  os.Getenv("VAULT_TOKEN"),
+			Client: &http.Client{Timeout: 5 * time.Second},
+		})
+	}
+	sources = append(sources, EnvSource{})
+	return NewSecretResolver(sources...)
+}
+
+func (r *SecretResolver) Sources() []SecretSource {
+	if r == nil {
+		return nil
+	}
+	return r.sources
+}
+
+func (r *SecretResolver) Resolve(ctx context.Context, key, fallback string) (string, error) {
+	for _, source := range r.sources {
+		value, ok, err := source.Lookup(ctx, key)
+		if err != nil {
+			return "", fmt.Errorf("resolve %s from %s: %w", key, source.Name(), err)
+		}
+		if ok && value != "" {
+			return value, nil
+		}
+	}
+	return fallback, nil
+}
+
+type RefreshedSecret struct {
+	name    string
+	resolve func(context.Context) (string, error)
+	value   atomic.Pointer[string]
+}
+
+func NewRefreshedSecret(name, initial string, resolve func(context.Context) (string, error)) *RefreshedSecret {
+	s := &RefreshedSecret{name: name, resolve: resolve}
+	s.value.Store(&initial)
+	return s
+}
+
+func (s *RefreshedSecret) Name() string {
+	return s.name
+}
+
+func (s *RefreshedSecret) Value() string {
+	return *s.value.Load()
+}
+
+func (s *RefreshedSecret) Refresh(ctx context.Context) (bool, error) {
+	value, err := s.resolve(ctx)
+	if err != nil {
+		return false, err
+	}
+	previous := s.value.Swap(&value)
+	return *previous != value, nil
+}
+
+type EnvSource struct{}
+
+func (EnvSource) Name() string {
+	return "env"
+}
+
+func (EnvSource) Lookup(_ context.Context, key string) (string, bool, error) {
+	value, ok := os.LookupEnv(key)
+	return value, ok, nil
+}
+
+type FileSource struct{}
+
+func (FileSource) Name() string {
+	return "file"
+}
+
+func (FileSource) Lookup(_ context.Context, key string) (string, bool, error) {
+	path := os.Getenv(key + "_FILE")
+	if path == "" {
+		return "", false, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, err
+	}
+
+	return strings.TrimSpace(string(data)), true, nil
+}
+
+type VaultSource struct {
+	Addr   string
+	Token  string
+	Client *http.Client
+}
+
+func (v *VaultSource) Name() string {
+	return "vault"
+}
+
+func (v *VaultSource) Lookup(ctx context.Context, key string) (string, bool, error) {
+	ref := os.Getenv(key + "_VAULT")
+	if ref == "" {
+		return "", false, nil
+	}
+
+	path, field, ok := strings.Cut(ref, "#")
+	if !ok || path == "" || field == "" {
+		return "", false, fmt.Errorf("%s_VAULT must be in form <path>#<field>", key)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.Addr+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return "", false, err
+	}
+	req.Header.Set("X-Vault-Token", v.Token)
+
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return "", false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", false, fmt.Errorf("vault responded with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", false, fmt.Errorf("decode vault response: %w", err)
+	}
+
+	data := body.Data
+	if nested, ok := data["data"].(map[string]any); ok {
+		data = nested
+	}
+	value, ok := data[field].(string)
+	if !ok {
+		return "", false, fmt.Errorf("field %q not found at %s", field, path)
+	}
+
+	return value, true, nil
+}
+
+func (v *VaultSource) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.Addr+"/v1/sys/health?standbyok=true", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vault responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package ctxutil
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type (
+	requestIDKey struct{}
+	callerKey    struct{}
+	loggerKey    struct{}
+	txKey        struct{}
+	traceKey     struct{}
+	dbSessionKey struct{}
+	replicaKey   struct{}
+	dryRunKey    struct{}
+)
+
+type Caller struct {
+	Trusted bool
+	UserID  string
+}
+
+type DBSession struct {
+	queries atomic.Int64
+	rows    atomic.Int64
+	nanos   atomic.Int64
+}
+
+func (s *DBSession) Record(elapsed time.Duration, rows int64) {
+	if s == nil {
+		return
+	}
+	s.queries.Add(1)
+	s.rows.Add(rows)
+	s.nanos.Add(int64(elapsed))
+}
+
+func (s *DBSession) Totals() (queries, rows int64, elapsed time.Duration) {
+	return s.queries.Load(), s.rows.Load(), time.Duration(s.nanos.Load())
+}
+
+type Trace struct {
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+	Flags        string
+	State        string
+}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func CallerFrom(ctx context.Context) Caller {
+	caller, _ := ctx.Value(callerKey{}).(Caller)
+	return caller
+}
+
+func WithLogger(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+func Logger(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	return fallback
+}
+
+func WithTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+func Tx(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return tx, ok
+}
+
+func WithTrace(ctx context.Context, trace Trace) context.Context {
+	return context.WithValue(ctx, traceKey{}, trace)
+}
+
+func TraceFrom(ctx context.Context) Trace {
+	trace, _ := ctx.Value(traceKey{}).(Trace)
+	return trace
+}
+
+func WithDBSession(ctx context.Context, session *DBSession) context.Context {
+	return context.WithValue(ctx, dbSessionKey{}, session)
+}
+
+func DBSessionFrom(ctx context.Context) *DBSession {
+	session, _ := ctx.Value(dbSessionKey{}).(*DBSession)
+	return session
+}
+
+func WithReplicaReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaKey{}, true)
+}
+
+func ReplicaReads(ctx context.Context) bool {
+	allowed, _ := ctx.Value(replicaKey{}).(bool)
+	return allowed
+}
+
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+func DryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
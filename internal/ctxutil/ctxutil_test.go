@@ -0,0 +1,134 @@
+package ctxutil
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func TestAccessorsOnEmptyContext(t *testing.T) {
+	ctx := context.Background()
+
+	if got := RequestID(ctx); got != "" {
+		t.Errorf("RequestID = %q, want empty", got)
+	}
+	if got := CallerFrom(ctx); got != (Caller{}) {
+		t.Errorf("CallerFrom = %+v, want zero", got)
+	}
+	fallback := zap.NewNop()
+	if got := Logger(ctx, fallback); got != fallback {
+		t.Errorf("Logger did not return fallback")
+	}
+	if tx, ok := Tx(ctx); ok || tx != nil {
+		t.Errorf("Tx = %v, %v, want nil, false", tx, ok)
+	}
+	if got := TraceFrom(ctx); got != (Trace{}) {
+		t.Errorf("TraceFrom = %+v, want zero", got)
+	}
+	if got := DBSessionFrom(ctx); got != nil {
+		t.Errorf("DBSessionFrom = %v, want nil", got)
+	}
+	if ReplicaReads(ctx) {
+		t.Errorf("ReplicaReads = true, want false")
+	}
+	if DryRun(ctx) {
+		t.Errorf("DryRun = true, want false")
+	}
+}
+
+func TestAccessorsRoundTrip(t *testing.T) {
+	logger := zap.NewNop()
+	trace := Trace{TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Flags: "01"}
+	session := &DBSession{}
+
+	ctx := context.Background()
+	ctx = WithRequestID(ctx, "req-1")
+	ctx = WithCaller(ctx, Caller{Trusted: true})
+	ctx = WithLogger(ctx, logger)
+	ctx = WithTrace(ctx, trace)
+	ctx = WithDBSession(ctx, session)
+	ctx = WithReplicaReads(ctx)
+	ctx = WithDryRun(ctx)
+
+	if got := RequestID(ctx); got != "req-1" {
+		t.Errorf("RequestID = %q, want %q", got, "req-1")
+	}
+	if got := CallerFrom(ctx); !got.Trusted {
+		t.Errorf("CallerFrom = %+v, want trusted", got)
+	}
+	if got := Logger(ctx, zap.NewExample()); got != logger {
+		t.Errorf("Logger did not return the stored logger")
+	}
+	if got := TraceFrom(ctx); got != trace {
+		t.Errorf("TraceFrom = %+v, want %+v", got, trace)
+	}
+	if got := DBSessionFrom(ctx); got != session {
+		t.Errorf("DBSessionFrom returned a different session")
+	}
+	if !ReplicaReads(ctx) {
+		t.Errorf("ReplicaReads = false, want true")
+	}
+	if !DryRun(ctx) {
+		t.Errorf("DryRun = false, want true")
+	}
+}
+
+func TestTxRoundTrip(t *testing.T) {
+	var tx pgx.Tx = fakeTx{}
+	got, ok := Tx(WithTx(context.Background(), tx))
+	if !ok || got != tx {
+		t.Errorf("Tx = %v, %v, want stored tx, true", got, ok)
+	}
+
+	got, ok = Tx(WithTx(context.Background(), nil))
+	if ok || got != nil {
+		t.Errorf("Tx with nil = %v, %v, want nil, false", got, ok)
+	}
+}
+
+func TestKeysDoNotCollide(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "request_id", "plain-string-key")
+	ctx = context.WithValue(ctx, struct{}{}, true)
+
+	if got := RequestID(ctx); got != "" {
+		t.Errorf("RequestID = %q, want empty for foreign key", got)
+	}
+	if ReplicaReads(ctx) || DryRun(ctx) {
+		t.Errorf("untyped keys leaked into typed accessors")
+	}
+
+	ctx = WithRequestID(ctx, "outer")
+	ctx = WithRequestID(ctx, "inner")
+	if got := RequestID(ctx); got != "inner" {
+		t.Errorf("RequestID = %q, want innermost value", got)
+	}
+}
+
+func TestDBSessionRecord(t *testing.T) {
+	var nilSession *DBSession
+	nilSession.Record(time.Second, 1)
+
+	session := &DBSession{}
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			session.Record(time.Millisecond, 3)
+		}()
+	}
+	wg.Wait()
+
+	queries, rows, elapsed := session.Totals()
+	if queries != 10 || rows != 30 || elapsed != 10*time.Millisecond {
+		t.Errorf("Totals = %d, %d, %s, want 10, 30, 10ms", queries, rows, elapsed)
+	}
+}
+
+type fakeTx struct {
+	pgx.Tx
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+type AgeBuckets struct {
+	Under1Day   int
+	From1To3Day int
+	From3To7Day int
+	Over7Day    int
+}
+
+func (b AgeBuckets) Total() int {
+	return b.Under1Day + b.From1To3Day + b.From3To7Day + b.Over7Day
+}
+
+type AgingPullRequest struct {
+	ID        string
+	Name      string
+	AuthorID  string
+	CreatedAt time.Time
+}
+
+type AgingSnapshot struct {
+	Date    time.Time
+	Buckets AgeBuckets
+}
+
+type PullRequestAging struct {
+	TeamName    string
+	GeneratedAt time.Time
+	Buckets     AgeBuckets
+	Oldest      []AgingPullRequest
+	History     []AgingSnapshot
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	MaxFreezeReasonLength = 500
+	MaxAssignmentFreeze   = 72 * time.Hour
+)
+
+type AssignmentFreeze struct {
+	ID        int64
+	Reason    string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	LiftedAt  *time.Time
+	CreatedAt time.Time
+}
+
+func NewAssignmentFreeze(reason string, startsAt, endsAt time.Time) (AssignmentFreeze, error) {
+	f := AssignmentFreeze{
+		Reason:   strings.TrimSpace(reason),
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}
+	if err := validateText("reason", f.Reason, MaxFreezeReasonLength); err != nil {
+		return AssignmentFreeze{}, err
+	}
+	if f.EndsAt.IsZero() {
+		return AssignmentFreeze{}, invalid("ends_at", "is required")
+	}
+	if !f.EndsAt.After(f.StartsAt) {
+		return AssignmentFreeze{}, invalid("ends_at", "must be after starts_at")
+	}
+	if f.EndsAt.Sub(f.StartsAt) > MaxAssignmentFreeze {
+		return AssignmentFreeze{}, invalid("ends_at", "must be at most 72h after starts_at")
+	}
+	return f, nil
+}
@@ -0,0 +1,24 @@
+package domain
+
+type AssignmentStrategyKind string
+
+const (
+	AssignmentStrategyRandom     AssignmentStrategyKind = "random"
+	AssignmentStrategyRoundRobin AssignmentStrategyKind = "round_robin"
+)
+
+func (k AssignmentStrategyKind) Valid() bool {
+	switch k {
+	case AssignmentStrategyRandom, AssignmentStrategyRoundRobin:
+		return true
+	default:
+		return false
+	}
+}
+
+func ValidateAssignmentStrategy(k AssignmentStrategyKind) error {
+	if k != "" && !k.Valid() {
+		return invalid("strategy", "must be one of random, round_robin or empty for the configured default")
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const calendarSearchDays = 2 * 366
+
+var ErrNoBusinessTime = errors.New("calendar has no business time left in search window")
+
+type Calendar struct {
+	Timezone  string
+	WorkStart time.Duration
+	WorkEnd   time.Duration
+	Workdays  []time.Weekday
+	Holidays  []string
+}
+
+type TeamCalendar struct {
+	TeamName  string
+	Calendar  Calendar
+	IsDefault bool
+}
+
+func (c Calendar) Validate() error {
+	if _, err := time.LoadLocation(c.Timezone); err != nil {
+		return fmt.Errorf("unknown timezone %q", c.Timezone)
+	}
+	if c.WorkStart < 0 || c.WorkEnd > 24*time.Hour || c.WorkStart >= c.WorkEnd {
+		return errors.New("work hours must satisfy 00:00 <= start < end <= 24:00")
+	}
+	if len(c.Workdays) == 0 {
+		return errors.New("at least one workday is required")
+	}
+	for _, d := range c.Workdays {
+		if d < time.Sunday || d > time.Saturday {
+			return fmt.Errorf("invalid workday %d", d)
+		}
+	}
+	for _, h := range c.Holidays {
+		if _, err := time.Parse(time.DateOnly, h); err != nil {
+			return fmt.Errorf("invalid holiday %q, expected YYYY-MM-DD", h)
+		}
+	}
+	return nil
+}
+
+func (c Calendar) AddBusinessTime(start time.Time, d time.Duration) (time.Time, error) {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t := start.In(loc)
+	if d <= 0 {
+		return t, nil
+	}
+
+	remaining := d
+	for i := 0; i < calendarSearchDays; i++ {
+		if c.isBusinessDay(t) {
+			open := c.at(t, c.WorkStart)
+			closeAt := c.at(t, c.WorkEnd)
+			if t.Before(open) {
+				t = open
+			}
+			if t.Before(closeAt) {
+				available := closeAt.Sub(t)
+				if remaining <= available {
+					return t.Add(remaining), nil
+				}
+				remaining -= available
+			}
+		}
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+	}
+
+	return time.Time{}, ErrNoBusinessTime
+}
+
+func (c Calendar) IsBusinessTime(t time.Time) bool {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+	if !c.isBusinessDay(t) {
+		return false
+	}
+	return !t.Before(c.at(t, c.WorkStart)) && t.Before(c.at(t, c.WorkEnd))
+}
+
+func (c Calendar) isBusinessDay(t time.Time) bool {
+	day := t.Format(time.DateOnly)
+	for _, h := range c.Holidays {
+		if h == day {
+			return false
+		}
+	}
+	for _, d := range c.Workdays {
+		if d == t.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Calendar) at(day time.Time, offset time.Duration) time.Time {
+	hours := int(offset / time.Hour)
+	minutes := int((offset % time.Hour) / time.Minute)
+	return time.Date(day.Year(), day.Month(), day.Day(), hours, minutes, 0, 0, day.Location())
+}
+
+func ParseClock(value string) (time.Duration, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	var hours, minutes int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hours, &minutes); err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	if hours < 0 || hours > 24 || minutes < 0 || minutes > 59 || (hours == 24 && minutes != 0) {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute, nil
+}
+
+func FormatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int((d%time.Hour)/time.Minute))
+}
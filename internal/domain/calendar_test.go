@@ -0,0 +1,289 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+var weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+var everyDay = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("parse %q: %v", value, err)
+	}
+	return parsed
+}
+
+func TestCalendarAddBusinessTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		cal   Calendar
+		start string
+		d     time.Duration
+		want  string
+	}{
+		{
+			name:  "within one day",
+			cal:   Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			start: "2025-06-02T10:00:00Z",
+			d:     2 * time.Hour,
+			want:  "2025-06-02T12:00:00Z",
+		},
+		{
+			name:  "before opening starts at opening",
+			cal:   Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			start: "2025-06-02T06:00:00Z",
+			d:     time.Hour,
+			want:  "2025-06-02T10:00:00Z",
+		},
+		{
+			name:  "ending exactly at close stays on the same day",
+			cal:   Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			start: "2025-06-02T17:00:00Z",
+			d:     time.Hour,
+			want:  "2025-06-02T18:00:00Z",
+		},
+		{
+			name:  "friday evening rolls over the weekend",
+			cal:   Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			start: "2025-06-06T17:00:00Z",
+			d:     2 * time.Hour,
+			want:  "2025-06-09T10:00:00Z",
+		},
+		{
+			name:  "spring forward shortens the working day",
+			cal:   Calendar{Timezone: "Europe/Berlin", WorkStart: time.Hour, WorkEnd: 5 * time.Hour, Workdays: everyDay},
+			start: "2025-03-30T01:00:00+01:00",
+			d:     3 * time.Hour,
+			want:  "2025-03-30T05:00:00+02:00",
+		},
+		{
+			name:  "spring forward spills into the next day",
+			cal:   Calendar{Timezone: "Europe/Berlin", WorkStart: time.Hour, WorkEnd: 5 * time.Hour, Workdays: everyDay},
+			start: "2025-03-30T01:00:00+01:00",
+			d:     4 * time.Hour,
+			want:  "2025-03-31T02:00:00+02:00",
+		},
+		{
+			name:  "fall back lengthens the working day",
+			cal:   Calendar{Timezone: "Europe/Berlin", WorkStart: time.Hour, WorkEnd: 5 * time.Hour, Workdays: everyDay},
+			start: "2025-10-26T01:00:00+02:00",
+			d:     5 * time.Hour,
+			want:  "2025-10-26T05:00:00+01:00",
+		},
+		{
+			name:  "us spring forward on a workday calendar",
+			cal:   Calendar{Timezone: "America/New_York", WorkStart: 9 * time.Hour, WorkEnd: 17 * time.Hour, Workdays: weekdays},
+			start: "2025-03-07T16:00:00-05:00",
+			d:     2 * time.Hour,
+			want:  "2025-03-10T10:00:00-04:00",
+		},
+		{
+			name:  "holiday on a workday is skipped",
+			cal:   Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays, Holidays: []string{"2025-12-29"}},
+			start: "2025-12-26T17:00:00Z",
+			d:     2 * time.Hour,
+			want:  "2025-12-30T10:00:00Z",
+		},
+		{
+			name:  "holiday on a weekend does not move the deadline",
+			cal:   Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays, Holidays: []string{"2025-12-27", "2025-12-28"}},
+			start: "2025-12-26T17:00:00Z",
+			d:     2 * time.Hour,
+			want:  "2025-12-29T10:00:00Z",
+		},
+		{
+			name:  "holiday on a working weekend day is skipped",
+			cal:   Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: everyDay, Holidays: []string{"2025-12-27"}},
+			start: "2025-12-26T17:00:00Z",
+			d:     2 * time.Hour,
+			want:  "2025-12-28T10:00:00Z",
+		},
+		{
+			name:  "holiday is matched in the team zone, not utc",
+			cal:   Calendar{Timezone: "Asia/Tokyo", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays, Holidays: []string{"2025-06-03"}},
+			start: "2025-06-02T23:00:00Z",
+			d:     time.Hour,
+			want:  "2025-06-04T10:00:00+09:00",
+		},
+		{
+			name:  "team zone ahead of utc",
+			cal:   Calendar{Timezone: "Asia/Tokyo", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			start: "2025-06-02T23:00:00Z",
+			d:     time.Hour,
+			want:  "2025-06-03T10:00:00+09:00",
+		},
+		{
+			name:  "team zone behind utc sees friday while utc is saturday",
+			cal:   Calendar{Timezone: "America/Los_Angeles", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			start: "2025-06-07T00:30:00Z",
+			d:     time.Hour,
+			want:  "2025-06-09T09:30:00-07:00",
+		},
+		{
+			name:  "half hour offset zone",
+			cal:   Calendar{Timezone: "Asia/Kolkata", WorkStart: 9*time.Hour + 30*time.Minute, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			start: "2025-06-02T03:00:00Z",
+			d:     time.Hour,
+			want:  "2025-06-02T10:30:00+05:30",
+		},
+		{
+			name:  "zero duration keeps the start",
+			cal:   Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			start: "2025-06-07T12:00:00Z",
+			d:     0,
+			want:  "2025-06-07T12:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cal.Validate(); err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			got, err := tt.cal.AddBusinessTime(mustTime(t, tt.start), tt.d)
+			if err != nil {
+				t.Fatalf("AddBusinessTime() error = %v", err)
+			}
+			if want := mustTime(t, tt.want); !got.Equal(want) {
+				t.Errorf("AddBusinessTime() = %s, want %s", got.Format(time.RFC3339), want.Format(time.RFC3339))
+			}
+			if got.Location().String() != tt.cal.Timezone {
+				t.Errorf("AddBusinessTime() location = %s, want %s", got.Location(), tt.cal.Timezone)
+			}
+		})
+	}
+}
+
+func TestCalendarAddBusinessTimeNoBusinessDays(t *testing.T) {
+	cal := Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: []time.Weekday{time.Monday}}
+	for day := mustTime(t, "2025-06-02T00:00:00Z"); day.Year() < 2028; day = day.AddDate(0, 0, 7) {
+		cal.Holidays = append(cal.Holidays, day.Format(time.DateOnly))
+	}
+
+	if _, err := cal.AddBusinessTime(mustTime(t, "2025-06-01T12:00:00Z"), time.Hour); err != ErrNoBusinessTime {
+		t.Fatalf("AddBusinessTime() error = %v, want %v", err, ErrNoBusinessTime)
+	}
+}
+
+func TestCalendarIsBusinessTime(t *testing.T) {
+	tests := []struct {
+		name string
+		cal  Calendar
+		at   string
+		want bool
+	}{
+		{
+			name: "opening is inclusive",
+			cal:  Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			at:   "2025-06-02T09:00:00Z",
+			want: true,
+		},
+		{
+			name: "closing is exclusive",
+			cal:  Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			at:   "2025-06-02T18:00:00Z",
+			want: false,
+		},
+		{
+			name: "weekend",
+			cal:  Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			at:   "2025-06-07T12:00:00Z",
+			want: false,
+		},
+		{
+			name: "holiday on a weekend",
+			cal:  Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: everyDay, Holidays: []string{"2025-06-07"}},
+			at:   "2025-06-07T12:00:00Z",
+			want: false,
+		},
+		{
+			name: "utc evening is team morning",
+			cal:  Calendar{Timezone: "Asia/Tokyo", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			at:   "2025-06-02T00:30:00Z",
+			want: true,
+		},
+		{
+			name: "utc monday is team sunday",
+			cal:  Calendar{Timezone: "America/Los_Angeles", WorkStart: 0, WorkEnd: 24 * time.Hour, Workdays: weekdays},
+			at:   "2025-06-02T03:00:00Z",
+			want: false,
+		},
+		{
+			name: "skipped dst hour is inside the working window",
+			cal:  Calendar{Timezone: "Europe/Berlin", WorkStart: time.Hour, WorkEnd: 5 * time.Hour, Workdays: everyDay},
+			at:   "2025-03-30T01:30:00Z",
+			want: true,
+		},
+		{
+			name: "unknown timezone",
+			cal:  Calendar{Timezone: "Mars/Olympus", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays},
+			at:   "2025-06-02T12:00:00Z",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.IsBusinessTime(mustTime(t, tt.at)); got != tt.want {
+				t.Errorf("IsBusinessTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cal     Calendar
+		wantErr bool
+	}{
+		{name: "valid", cal: Calendar{Timezone: "Europe/Moscow", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays, Holidays: []string{"2025-01-01"}}},
+		{name: "unknown timezone", cal: Calendar{Timezone: "Nowhere/City", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays}, wantErr: true},
+		{name: "end before start", cal: Calendar{Timezone: "UTC", WorkStart: 18 * time.Hour, WorkEnd: 9 * time.Hour, Workdays: weekdays}, wantErr: true},
+		{name: "past midnight", cal: Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 25 * time.Hour, Workdays: weekdays}, wantErr: true},
+		{name: "no workdays", cal: Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour}, wantErr: true},
+		{name: "invalid weekday", cal: Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: []time.Weekday{7}}, wantErr: true},
+		{name: "invalid holiday", cal: Calendar{Timezone: "UTC", WorkStart: 9 * time.Hour, WorkEnd: 18 * time.Hour, Workdays: weekdays, Holidays: []string{"2025-02-30"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cal.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "09:00", want: 9 * time.Hour},
+		{value: "9:30", want: 9*time.Hour + 30*time.Minute},
+		{value: "24:00", want: 24 * time.Hour},
+		{value: "24:01", wantErr: true},
+		{value: "12:60", wantErr: true},
+		{value: "1200", wantErr: true},
+		{value: "ab:cd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseClock(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseClock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseClock() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
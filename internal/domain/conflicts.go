@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+const MaxConflictDetailsLength = 500
+
+type ConflictCategory string
+
+const (
+	ConflictPersonalRelationship ConflictCategory = "personal_relationship"
+	ConflictFinancialInterest    ConflictCategory = "financial_interest"
+	ConflictReportingLine        ConflictCategory = "reporting_line"
+	ConflictPriorInvolvement     ConflictCategory = "prior_involvement"
+	ConflictOther                ConflictCategory = "other"
+)
+
+func (c ConflictCategory) Valid() bool {
+	switch c {
+	case ConflictPersonalRelationship, ConflictFinancialInterest, ConflictReportingLine, ConflictPriorInvolvement, ConflictOther:
+		return true
+	default:
+		return false
+	}
+}
+
+type ConflictDeclaration struct {
+	PullRequestID string
+	ReviewerID    string
+	Category      ConflictCategory
+	Details       string
+	ReplacedBy    string
+	DeclaredAt    time.Time
+}
+
+func NewConflictDeclaration(prID, reviewerID string, category ConflictCategory, details string) (ConflictDeclaration, error) {
+	d := ConflictDeclaration{
+		PullRequestID: NormalizeID(prID),
+		ReviewerID:    NormalizeID(reviewerID),
+		Category:      category,
+		Details:       strings.TrimSpace(details),
+	}
+	if err := validateText("pull_request_id", d.PullRequestID, MaxIDLength); err != nil {
+		return ConflictDeclaration{}, err
+	}
+	if err := validateText("reviewer_id", d.ReviewerID, MaxIDLength); err != nil {
+		return ConflictDeclaration{}, err
+	}
+	if !d.Category.Valid() {
+		return ConflictDeclaration{}, invalid("category", "must be one of personal_relationship, financial_interest, reporting_line, prior_involvement, other")
+	}
+	if d.Details != "" || d.Category == ConflictOther {
+		if err := validateText("details", d.Details, MaxConflictDetailsLength); err != nil {
+			return ConflictDeclaration{}, err
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,61 @@
+package domain
+
+import "time"
+
+type DeclineReason string
+
+const (
+	DeclineOverloaded   DeclineReason = "overloaded"
+	DeclineOnLeave      DeclineReason = "on_leave"
+	DeclineConflict     DeclineReason = "conflict"
+	DeclineLacksContext DeclineReason = "lacks_context"
+	DeclineOther        DeclineReason = "other"
+)
+
+var DeclineReasons = []DeclineReason{DeclineOverloaded, DeclineOnLeave, DeclineConflict, DeclineLacksContext, DeclineOther}
+
+func (r DeclineReason) Valid() bool {
+	switch r {
+	case DeclineOverloaded, DeclineOnLeave, DeclineConflict, DeclineLacksContext, DeclineOther:
+		return true
+	default:
+		return false
+	}
+}
+
+func NewDeclineReason(reason DeclineReason, note string, required bool) (DeclineReason, error) {
+	if reason == "" {
+		if required {
+			return "", invalid("reason", "is required: one of overloaded, on_leave, conflict, lacks_context, other")
+		}
+		return "", nil
+	}
+	if !reason.Valid() {
+		return "", invalid("reason", "must be one of overloaded, on_leave, conflict, lacks_context, other")
+	}
+	if reason == DeclineOther && note == "" {
+		return "", invalid("note", "is required when reason is other")
+	}
+	return reason, nil
+}
+
+type ReviewerDecline struct {
+	PullRequestID string
+	ReviewerID    string
+	Reason        DeclineReason
+	Note          string
+	ReplacedBy    string
+	DeclinedAt    time.Time
+}
+
+type DeclineReasonCount struct {
+	Reason DeclineReason
+	Count  int
+}
+
+type DeclineReasonStats struct {
+	TeamName    string
+	Total       int
+	Unspecified int
+	Reasons     []DeclineReasonCount
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+const MaxExclusionReasonLength = 500
+
+type ReviewerExclusion struct {
+	UserA     string
+	UserB     string
+	Reason    string
+	CreatedAt time.Time
+}
+
+func NewReviewerExclusion(userA, userB, reason string) (ReviewerExclusion, error) {
+	e := ReviewerExclusion{
+		UserA:  NormalizeID(userA),
+		UserB:  NormalizeID(userB),
+		Reason: strings.TrimSpace(reason),
+	}
+	if err := validateText("user_a_id", e.UserA, MaxIDLength); err != nil {
+		return ReviewerExclusion{}, err
+	}
+	if err := validateText("user_b_id", e.UserB, MaxIDLength); err != nil {
+		return ReviewerExclusion{}, err
+	}
+	if e.UserA == e.UserB {
+		return ReviewerExclusion{}, invalid("user_b_id", "must differ from user_a_id")
+	}
+	if e.Reason != "" {
+		if err := validateText("reason", e.Reason, MaxExclusionReasonLength); err != nil {
+			return ReviewerExclusion{}, err
+		}
+	}
+	if e.UserB < e.UserA {
+		e.UserA, e.UserB = e.UserB, e.UserA
+	}
+	return e, nil
+}
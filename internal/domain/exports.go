@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+const ExportKindReviewerHistory = "reviewer_history"
+
+type ReviewerHistoryRecord struct {
+	PullRequestID   string
+	AuthorID        string
+	ReviewerID      string
+	Kind            AssignmentKind
+	Reassigned      bool
+	HandoffFrom     string
+	AssignedAt      time.Time
+	FirstResponseAt *time.Time
+}
+
+type HistoryExport struct {
+	Kind       string
+	Date       time.Time
+	ObjectKey  string
+	Rows       int
+	Bytes      int
+	ExportedAt time.Time
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const MaxFocusWindows = 8
+
+type FocusWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+type FocusSchedule struct {
+	UserID   string
+	Timezone string
+	Windows  []FocusWindow
+}
+
+func NewFocusSchedule(userID, timezone string, windows []FocusWindow) (FocusSchedule, error) {
+	f := FocusSchedule{UserID: NormalizeID(userID), Timezone: timezone}
+	if err := validateText("user_id", f.UserID, MaxIDLength); err != nil {
+		return FocusSchedule{}, err
+	}
+	if len(windows) > MaxFocusWindows {
+		return FocusSchedule{}, invalid("windows", fmt.Sprintf("must contain at most %d entries", MaxFocusWindows))
+	}
+	if len(windows) == 0 {
+		f.Timezone = ""
+		return f, nil
+	}
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+		return FocusSchedule{}, invalid("timezone", "must be a known IANA timezone")
+	}
+
+	f.Windows = slices.Clone(windows)
+	slices.SortFunc(f.Windows, func(a, b FocusWindow) int { return int(a.Start - b.Start) })
+	for i, w := range f.Windows {
+		if w.Start < 0 || w.End > 24*time.Hour || w.Start >= w.End {
+			return FocusSchedule{}, invalid("windows", "must satisfy 00:00 <= start < end <= 24:00")
+		}
+		if i > 0 && w.Start < f.Windows[i-1].End {
+			return FocusSchedule{}, invalid("windows", "must not overlap")
+		}
+	}
+	return f, nil
+}
+
+func (f FocusSchedule) HeldUntil(t time.Time) (time.Time, bool) {
+	if len(f.Windows) == 0 {
+		return time.Time{}, false
+	}
+	loc, err := time.LoadLocation(f.Timezone)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	until := t.In(loc)
+	held := false
+	for n := 0; n <= len(f.Windows); n++ {
+		day := time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, loc)
+		offset := until.Sub(day)
+		i := slices.IndexFunc(f.Windows, func(w FocusWindow) bool { return w.Start <= offset && offset < w.End })
+		if i < 0 {
+			break
+		}
+		held = true
+		end := f.Windows[i].End
+		until = time.Date(day.Year(), day.Month(), day.Day(), int(end/time.Hour), int((end%time.Hour)/time.Minute), 0, 0, loc)
+	}
+	if !held {
+		return time.Time{}, false
+	}
+	return until.UTC(), true
+}
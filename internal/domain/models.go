@@ -3,23 +3,81 @@ package domain
 import "time"
 
 type Team struct {
-	ID      int64
-	Name    string
-	Members []TeamMember
+	ID                 int64
+	Name               string
+	Members            []TeamMember
+	Checklist          []ChecklistItem
+	ChecklistRequired  bool
+	Quorum             []QuorumRule
+	UniqueOpenPRNames  bool
+	RampUpDays         int
+	MentoringShadows   bool
+	AssignmentStrategy AssignmentStrategyKind
+}
+
+type PullRequestNameConflict struct {
+	AuthorID        string
+	PullRequestName string
+	EnforcedID      string
+	DuplicateIDs    []string
+}
+
+type ChecklistItem struct {
+	ID    int64
+	Title string
+}
+
+type ChecklistItemState struct {
+	ItemID    int64
+	Title     string
+	CheckedBy *string
+	CheckedAt *time.Time
 }
 
 type TeamMember struct {
-	UserID   string
-	Username string
-	IsActive bool
+	UserID       string
+	Username     string
+	IsActive     bool
+	Seniority    Seniority
+	RampUpUntil  *time.Time
+	WorkingHours WorkingHours
+}
+
+type TeamMemberCounts struct {
+	Total  int
+	Active int
 }
 
 type User struct {
-	ID       string
-	Username string
-	IsActive bool
-	TeamID   *int64
-	TeamName *string
+	ID        string
+	Username  string
+	IsActive  bool
+	Seniority Seniority
+	TeamID    *int64
+	TeamName  *string
+	Version   int64
+}
+
+type Seniority string
+
+const (
+	SeniorityJunior Seniority = "junior"
+	SeniorityMiddle Seniority = "middle"
+	SenioritySenior Seniority = "senior"
+)
+
+func (s Seniority) Valid() bool {
+	switch s {
+	case SeniorityJunior, SeniorityMiddle, SenioritySenior:
+		return true
+	default:
+		return false
+	}
+}
+
+type QuorumRule struct {
+	Seniority    Seniority
+	MinReviewers int
 }
 
 type PullRequestStatus string
@@ -30,18 +88,357 @@ const (
 )
 
 type PullRequest struct {
+	ID          string
+	Name        string
+	AuthorID    string
+	Status      PullRequestStatus
+	CreatedAt   time.Time
+	MergedAt    *time.Time
+	MergedBy    *string
+	Reviewers   []string
+	Shadows     []string
+	ReviewDueAt *time.Time
+	Checklist   []ChecklistItemState
+
+	Assignments []ReviewerAssignment
+	BlockedBy   []PullRequestShort
+
+	DeletedAt *time.Time
+	DeletedBy *string
+
+	ApprovalsResetAt *time.Time
+
+	LinesChanged *int
+
+	AssignmentDeferredAt *time.Time
+}
+
+type AssignmentKind string
+
+const (
+	AssignmentKindRegular AssignmentKind = "regular"
+	AssignmentKindShadow  AssignmentKind = "shadow"
+)
+
+type ReviewerAssignment struct {
+	ReviewerID      string
+	Kind            AssignmentKind
+	AssignedAt      time.Time
+	FirstResponseAt *time.Time
+	CompletedAt     *time.Time
+	ApprovedAt      *time.Time
+	SnoozedUntil    *time.Time
+	SnoozeUsed      time.Duration
+	Handoff         *Handoff
+}
+
+type Handoff struct {
+	FromReviewerID string
+	Note           string
+}
+
+func (pr PullRequest) Approvals() int {
+	n := 0
+	for _, a := range pr.Assignments {
+		if a.Kind == AssignmentKindRegular && a.ApprovedAt != nil {
+			n++
+		}
+	}
+	return n
+}
+
+type Page struct {
+	Limit  int
+	Offset int
+	SortBy string
+	Desc   bool
+}
+
+type MergeActor struct {
+	MergedBy string
+	MergedAt *time.Time
+}
+
+type PullRequestShort struct {
 	ID        string
 	Name      string
 	AuthorID  string
 	Status    PullRequestStatus
+	DeletedAt *time.Time
+
+	ApprovalsResetAt *time.Time
+}
+
+type TeamApplyAction string
+
+const (
+	TeamApplyActionCreate TeamApplyAction = "CREATE"
+	TeamApplyActionUpdate TeamApplyAction = "UPDATE"
+	TeamApplyActionNoop   TeamApplyAction = "NOOP"
+)
+
+type TeamPlan struct {
+	TeamName       string
+	Action         TeamApplyAction
+	AddedMembers   []string
+	UpdatedMembers []string
+	RemovedMembers []string
+}
+
+type AssignmentShadowSample struct {
+	PullRequestID    string
+	PrimaryReviewers []string
+	ShadowReviewers  []string
+	Diverged         bool
+	CreatedAt        time.Time
+}
+
+type AssignmentShadowReport struct {
+	Total    int
+	Diverged int
+	Recent   []AssignmentShadowSample
+}
+
+type FirstResponseStats struct {
+	TeamName            string
+	Responded           int
+	Pending             int
+	MedianFirstResponse time.Duration
+}
+
+type LeaderboardEntry struct {
+	UserID              string
+	Username            string
+	CompletedReviews    int
+	MedianFirstResponse time.Duration
+	Saves               int
+}
+
+type ConsistencyCheck string
+
+const (
+	ConsistencyReviewerInactive   ConsistencyCheck = "reviewer_inactive"
+	ConsistencyReviewerNotInTeam  ConsistencyCheck = "reviewer_not_in_team"
+	ConsistencyAuthorIsReviewer   ConsistencyCheck = "author_is_reviewer"
+	ConsistencyMergedWithoutTime  ConsistencyCheck = "merged_without_merged_at"
+	ConsistencyOpenWithMergeTime  ConsistencyCheck = "open_with_merged_at"
+	ConsistencyOrphanedMembership ConsistencyCheck = "orphaned_membership"
+)
+
+type ConsistencyViolation struct {
+	Check        ConsistencyCheck
+	Subject      string
+	Detail       string
+	SuggestedFix string
+}
+
+type AnnouncementSeverity string
+
+const (
+	AnnouncementSeverityInfo     AnnouncementSeverity = "info"
+	AnnouncementSeverityWarning  AnnouncementSeverity = "warning"
+	AnnouncementSeverityCritical AnnouncementSeverity = "critical"
+)
+
+func (s AnnouncementSeverity) Valid() bool {
+	switch s {
+	case AnnouncementSeverityInfo, AnnouncementSeverityWarning, AnnouncementSeverityCritical:
+		return true
+	default:
+		return false
+	}
+}
+
+type Announcement struct {
+	ID        int64
+	Message   string
+	Severity  AnnouncementSeverity
+	StartsAt  time.Time
+	EndsAt    *time.Time
 	CreatedAt time.Time
-	MergedAt  *time.Time
+}
+
+func (a Announcement) ActiveAt(t time.Time) bool {
+	return !t.Before(a.StartsAt) && (a.EndsAt == nil || t.Before(*a.EndsAt))
+}
+
+type InvariantGauges struct {
+	TeamsWithoutActiveMembers int64
+	UnderstaffedPullRequests  int64
+	InactiveAssignedReviewers int64
+	CollectedAt               time.Time
+}
+
+type TableStats struct {
+	Name                 string
+	LiveRows             int64
+	DeadRows             int64
+	ModifiedSinceAnalyze int64
+	TotalBytes           int64
+	TableBytes           int64
+	LastAnalyzedAt       *time.Time
+}
+
+type IndexStats struct {
+	Name                string
+	Table               string
+	Bytes               int64
+	EstimatedBloatBytes int64
+	Scans               int64
+}
+
+type DBStats struct {
+	Tables  []TableStats
+	Indexes []IndexStats
+}
+
+type ReviewerLoad struct {
+	UserID          string
+	Username        string
+	IsActive        bool
+	PendingReviews  int
+	HandledReviews  int
+	OverdueReviews  int
+	ProjectedLoad   float64
+	WeeklyCapacity  float64
+	LikelySLABreach bool
+}
+
+type ReviewForecast struct {
+	HistoryWeeks       int
+	WeeklyPullRequests float64
+	ReviewersPerPR     int
+	Members            []ReviewerLoad
+	Warnings           []string
+}
+
+type PullRequestStatusSummary struct {
+	ID                string
+	Status            PullRequestStatus
+	Reviewers         []string
+	Responded         []string
+	Approvals         int
+	RequiredApprovals int
+	QuorumMet         bool
+}
+
+func ApprovalsMet(approvals, required, reviewers int) bool {
+	return approvals >= min(required, reviewers)
+}
+
+func (s PullRequestStatusSummary) ReviewComplete() bool {
+	if len(s.Reviewers) == 0 || !s.QuorumMet {
+		return false
+	}
+	if s.RequiredApprovals > 0 {
+		return ApprovalsMet(s.Approvals, s.RequiredApprovals, len(s.Reviewers))
+	}
+	return len(s.Responded) == len(s.Reviewers)
+}
+
+type TeamOverview struct {
+	TeamName      string
+	Members       int
+	ActiveMembers int
+}
+
+type OpenPullRequestOverview struct {
+	ID        string
+	Name      string
+	AuthorID  string
+	TeamName  string
 	Reviewers []string
+	CreatedAt time.Time
 }
 
-type PullRequestShort struct {
-	ID       string
-	Name     string
-	AuthorID string
-	Status   PullRequestStatus
+type ReviewerLoadOverview struct {
+	UserID      string
+	Username    string
+	TeamName    string
+	IsActive    bool
+	OpenReviews int
+}
+
+type AdminOverview struct {
+	Teams                []TeamOverview
+	OpenPullRequests     []OpenPullRequestOverview
+	Reviewers            []ReviewerLoadOverview
+	ReviewersRefreshedAt *time.Time
+}
+
+type LegacyImport struct {
+	Teams        []Team
+	PullRequests []PullRequest
+}
+
+type LegacyImportIssue struct {
+	Item    string
+	Message string
+}
+
+type LegacyImportReport struct {
+	DryRun       bool
+	Applied      bool
+	Teams        int
+	Users        int
+	PullRequests int
+	Reviewers    int
+	Issues       []LegacyImportIssue
+	Conflicts    []LegacyImportIssue
+}
+
+type CalendarEvent struct {
+	UID       string
+	Summary   string
+	Organizer string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Cancelled bool
+}
+
+type Absence struct {
+	UserID     string
+	ExternalID string
+	StartsAt   time.Time
+	EndsAt     time.Time
+}
+
+type AbsenceSyncIssue struct {
+	EventUID string
+	Message  string
+}
+
+type AbsenceSyncReport struct {
+	RanAt       time.Time
+	Events      int
+	Absences    int
+	Cancelled   int
+	Deactivated []string
+	Restored    []string
+	Issues      []AbsenceSyncIssue
+}
+
+type OpenAssignment struct {
+	PullRequestID string
+	Kind          AssignmentKind
+}
+
+type BulkReassignStatus string
+
+const (
+	BulkReassignReassigned  BulkReassignStatus = "REASSIGNED"
+	BulkReassignNoCandidate BulkReassignStatus = "NO_CANDIDATE"
+	BulkReassignSkipped     BulkReassignStatus = "SKIPPED"
+)
+
+type BulkReassignResult struct {
+	PullRequestID string
+	Status        BulkReassignStatus
+	ReplacedBy    string
+	Reason        string
+}
+
+type BulkReassignReport struct {
+	UserID  string
+	Results []BulkReassignResult
 }
@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func NormalizeID(id string) string {
+	return norm.NFC.String(strings.TrimSpace(id))
+}
+
+func NormalizeTeamName(name string) string {
+	return norm.NFC.String(strings.TrimSpace(name))
+}
+
+func TeamNameKey(name string) string {
+	return strings.ToLower(NormalizeTeamName(name))
+}
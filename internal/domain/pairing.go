@@ -0,0 +1,19 @@
+package domain
+
+type PairingNode struct {
+	UserID   string
+	Username string
+	IsMember bool
+}
+
+type PairingEdge struct {
+	AuthorID   string
+	ReviewerID string
+	Reviews    int
+}
+
+type PairingGraph struct {
+	TeamName string
+	Nodes    []PairingNode
+	Edges    []PairingEdge
+}
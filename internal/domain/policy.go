@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const MaxReviewersPerPR = 10
+
+type PolicyKey string
+
+const (
+	PolicyReviewersPerPR PolicyKey = "reviewers_per_pr"
+	PolicyReviewSLA      PolicyKey = "review_sla"
+	PolicySnoozeBudget   PolicyKey = "snooze_budget"
+	PolicyMinTZOverlap   PolicyKey = "min_timezone_overlap"
+	PolicyMinApprovals   PolicyKey = "min_approvals"
+)
+
+var PolicyKeys = []PolicyKey{PolicyReviewersPerPR, PolicyReviewSLA, PolicySnoozeBudget, PolicyMinTZOverlap, PolicyMinApprovals}
+
+type PolicySource string
+
+const (
+	PolicySourceConfig      PolicySource = "config"
+	PolicySourceOrg         PolicySource = "org"
+	PolicySourceTeam        PolicySource = "team"
+	PolicySourcePullRequest PolicySource = "pull_request"
+	PolicySourceQuorum      PolicySource = "quorum"
+)
+
+func (k PolicyKey) Scopes() []PolicySource {
+	switch k {
+	case PolicyReviewersPerPR, PolicyReviewSLA, PolicyMinTZOverlap:
+		return []PolicySource{PolicySourceOrg, PolicySourceTeam}
+	case PolicySnoozeBudget, PolicyMinApprovals:
+		return []PolicySource{PolicySourceOrg, PolicySourceTeam, PolicySourcePullRequest}
+	default:
+		return nil
+	}
+}
+
+type PolicyOverride struct {
+	Scope         PolicySource
+	TeamName      string
+	PullRequestID string
+	Key           PolicyKey
+	Value         string
+	UpdatedAt     time.Time
+}
+
+type PolicyLayer struct {
+	Source PolicySource
+	Value  string
+}
+
+type EffectivePolicy struct {
+	Key    PolicyKey
+	Value  string
+	Source PolicySource
+	Layers []PolicyLayer
+}
+
+type PolicyResolution struct {
+	TeamName      string
+	PullRequestID string
+	Policies      []EffectivePolicy
+}
+
+func (r PolicyResolution) Get(key PolicyKey) EffectivePolicy {
+	for _, p := range r.Policies {
+		if p.Key == key {
+			return p
+		}
+	}
+	return EffectivePolicy{Key: key}
+}
+
+func (p EffectivePolicy) Int() int {
+	n, _ := strconv.Atoi(p.Value)
+	return n
+}
+
+func (p EffectivePolicy) Duration() time.Duration {
+	d, _ := time.ParseDuration(p.Value)
+	return d
+}
+
+func NewPolicyOverride(scope PolicySource, teamName, prID string, key PolicyKey, value string) (PolicyOverride, error) {
+	o := PolicyOverride{Scope: scope, Key: key}
+	switch scope {
+	case PolicySourceOrg:
+	case PolicySourceTeam:
+		o.TeamName = NormalizeTeamName(teamName)
+		if err := validateText("team_name", o.TeamName, MaxTeamNameLength); err != nil {
+			return PolicyOverride{}, err
+		}
+	case PolicySourcePullRequest:
+		o.PullRequestID = NormalizeID(prID)
+		if err := validateText("pull_request_id", o.PullRequestID, MaxIDLength); err != nil {
+			return PolicyOverride{}, err
+		}
+	default:
+		return PolicyOverride{}, invalid("scope", "must be one of org, team, pull_request")
+	}
+
+	scopes := key.Scopes()
+	if scopes == nil {
+		return PolicyOverride{}, invalid("key", fmt.Sprintf("unknown policy %q", key))
+	}
+	if !slices.Contains(scopes, scope) {
+		return PolicyOverride{}, invalid("scope", fmt.Sprintf("policy %s cannot be overridden at %s scope", key, scope))
+	}
+
+	if value == "" {
+		return o, nil
+	}
+	normalized, err := NormalizePolicyValue(key, value)
+	if err != nil {
+		return PolicyOverride{}, err
+	}
+	o.Value = normalized
+	return o, nil
+}
+
+func NormalizePolicyValue(key PolicyKey, value string) (string, error) {
+	switch key {
+	case PolicyReviewersPerPR:
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > MaxReviewersPerPR {
+			return "", invalid("value", fmt.Sprintf("must be an integer between 1 and %d", MaxReviewersPerPR))
+		}
+		return strconv.Itoa(n), nil
+	case PolicyMinApprovals:
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 || n > MaxReviewersPerPR {
+			return "", invalid("value", fmt.Sprintf("must be an integer between 0 and %d", MaxReviewersPerPR))
+		}
+		return strconv.Itoa(n), nil
+	case PolicyReviewSLA, PolicySnoozeBudget:
+		d, err := time.ParseDuration(value)
+		if err != nil || d < 0 {
+			return "", invalid("value", "must be a non-negative duration such as 24h or 90m")
+		}
+		return d.String(), nil
+	case PolicyMinTZOverlap:
+		d, err := time.ParseDuration(value)
+		if err != nil || d < 0 || d > 24*time.Hour {
+			return "", invalid("value", "must be a duration between 0s and 24h")
+		}
+		return d.String(), nil
+	default:
+		return "", invalid("key", fmt.Sprintf("unknown policy %q", key))
+	}
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultSessionLineBudget = 800
+	MaxSessionLineBudget     = 10000
+	UnknownPullRequestLines  = 200
+)
+
+type ReviewSessionStatus string
+
+const (
+	ReviewSessionSuggested ReviewSessionStatus = "suggested"
+	ReviewSessionAccepted  ReviewSessionStatus = "accepted"
+	ReviewSessionCompleted ReviewSessionStatus = "completed"
+)
+
+type ReviewSession struct {
+	ID          string
+	ReviewerID  string
+	Status      ReviewSessionStatus
+	LineBudget  int
+	Items       []ReviewSessionItem
+	CreatedAt   time.Time
+	AcceptedAt  *time.Time
+	CompletedAt *time.Time
+}
+
+type ReviewSessionItem struct {
+	PullRequestID  string
+	Name           string
+	AuthorID       string
+	ReviewDueAt    *time.Time
+	LinesChanged   *int
+	EstimatedLines int
+}
+
+func (s ReviewSession) EstimatedLines() int {
+	total := 0
+	for _, item := range s.Items {
+		total += item.EstimatedLines
+	}
+	return total
+}
+
+func NewSessionLineBudget(budget int) (int, error) {
+	if budget == 0 {
+		return DefaultSessionLineBudget, nil
+	}
+	if budget < 0 || budget > MaxSessionLineBudget {
+		return 0, invalid("max_lines", fmt.Sprintf("must be between 1 and %d", MaxSessionLineBudget))
+	}
+	return budget, nil
+}
+
+func EstimateReviewLines(linesChanged *int) int {
+	if linesChanged == nil {
+		return UnknownPullRequestLines
+	}
+	return max(*linesChanged, 1)
+}
+
+func PlanReviewSession(candidates []ReviewSessionItem, budget int) []ReviewSessionItem {
+	var planned []ReviewSessionItem
+	total := 0
+	for _, item := range candidates {
+		item.EstimatedLines = EstimateReviewLines(item.LinesChanged)
+		if len(planned) > 0 && total+item.EstimatedLines > budget {
+			break
+		}
+		planned = append(planned, item)
+		total += item.EstimatedLines
+	}
+	return planned
+}
@@ -0,0 +1,95 @@
+package domain
+
+import "time"
+
+const week = 7 * 24 * time.Hour
+
+type RotationMember struct {
+	UserID    string
+	Seniority Seniority
+	Eligible  bool
+}
+
+type Rotation struct {
+	StartsOn time.Time
+	Members  []RotationMember
+}
+
+type RotationWeek struct {
+	WeekStart time.Time
+	UserID    string
+	Scheduled string
+}
+
+type TeamRotation struct {
+	TeamName string
+	Rotation Rotation
+	Schedule []RotationWeek
+}
+
+func NewRotation(members []string, startsOn string) (Rotation, error) {
+	start, err := time.Parse(time.DateOnly, startsOn)
+	if err != nil {
+		return Rotation{}, invalid("starts_on", "must be a date in YYYY-MM-DD format")
+	}
+
+	rotation := Rotation{StartsOn: WeekStart(start)}
+	seen := make(map[string]bool, len(members))
+	for _, m := range members {
+		userID := NormalizeID(m)
+		if err := validateText("members", userID, MaxIDLength); err != nil {
+			return Rotation{}, err
+		}
+		if seen[userID] {
+			return Rotation{}, invalid("members", "contains duplicate "+userID)
+		}
+		seen[userID] = true
+		rotation.Members = append(rotation.Members, RotationMember{UserID: userID})
+	}
+	return rotation, nil
+}
+
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
+
+func (r Rotation) scheduledIndex(t time.Time) int {
+	n := len(r.Members)
+	weeks := int(WeekStart(t).Sub(r.StartsOn) / week)
+	return ((weeks % n) + n) % n
+}
+
+func (r Rotation) OnDutyAt(t time.Time, skip func(userID string) bool) (RotationMember, bool) {
+	if len(r.Members) == 0 {
+		return RotationMember{}, false
+	}
+	start := r.scheduledIndex(t)
+	for i := range r.Members {
+		m := r.Members[(start+i)%len(r.Members)]
+		if m.Eligible && (skip == nil || !skip(m.UserID)) {
+			return m, true
+		}
+	}
+	return RotationMember{}, false
+}
+
+func (r Rotation) Schedule(from time.Time, weeks int) []RotationWeek {
+	if len(r.Members) == 0 {
+		return nil
+	}
+	schedule := make([]RotationWeek, 0, weeks)
+	for i := 0; i < weeks; i++ {
+		weekStart := WeekStart(from).Add(time.Duration(i) * week)
+		entry := RotationWeek{
+			WeekStart: weekStart,
+			Scheduled: r.Members[r.scheduledIndex(weekStart)].UserID,
+		}
+		if m, ok := r.OnDutyAt(weekStart, nil); ok {
+			entry.UserID = m.UserID
+		}
+		schedule = append(schedule, entry)
+	}
+	return schedule
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SyncEntity string
+
+const (
+	SyncEntityTeam        SyncEntity = "team"
+	SyncEntityUser        SyncEntity = "user"
+	SyncEntityPullRequest SyncEntity = "pull_request"
+)
+
+type SyncCursor struct {
+	TxID int64
+	Seq  int64
+}
+
+func ParseSyncCursor(value string) (SyncCursor, error) {
+	txID, seq, found := strings.Cut(value, ".")
+	if !found {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n < 0 {
+			return SyncCursor{}, fmt.Errorf("invalid sync cursor %q", value)
+		}
+		return SyncCursor{Seq: n}, nil
+	}
+	var c SyncCursor
+	var err error
+	if c.TxID, err = strconv.ParseInt(txID, 10, 64); err != nil || c.TxID < 0 {
+		return SyncCursor{}, fmt.Errorf("invalid sync cursor %q", value)
+	}
+	if c.Seq, err = strconv.ParseInt(seq, 10, 64); err != nil || c.Seq < 0 {
+		return SyncCursor{}, fmt.Errorf("invalid sync cursor %q", value)
+	}
+	return c, nil
+}
+
+func (c SyncCursor) String() string {
+	return strconv.FormatInt(c.TxID, 10) + "." + strconv.FormatInt(c.Seq, 10)
+}
+
+func (c SyncCursor) IsZero() bool {
+	return c == SyncCursor{}
+}
+
+func (c SyncCursor) Legacy() bool {
+	return c.TxID == 0 && c.Seq > 0
+}
+
+func (c SyncCursor) Compare(other SyncCursor) int {
+	return cmp.Or(cmp.Compare(c.TxID, other.TxID), cmp.Compare(c.Seq, other.Seq))
+}
+
+type SyncChange struct {
+	Cursor      SyncCursor
+	Entity      SyncEntity
+	ID          string
+	Deleted     bool
+	Team        *SyncTeam
+	User        *SyncUser
+	PullRequest *SyncPullRequest
+}
+
+type SyncTeam struct {
+	Name      string
+	MemberIDs []string
+	UpdatedAt time.Time
+}
+
+type SyncUser struct {
+	ID        string
+	Username  string
+	IsActive  bool
+	TeamName  string
+	UpdatedAt time.Time
+}
+
+type SyncPullRequest struct {
+	ID        string
+	Name      string
+	AuthorID  string
+	Status    PullRequestStatus
+	Reviewers []string
+	CreatedAt time.Time
+	MergedAt  *time.Time
+	UpdatedAt time.Time
+}
+
+type SyncPage struct {
+	Changes []SyncChange
+	Cursor  SyncCursor
+	HasMore bool
+}
@@ -0,0 +1,50 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func TestParseSyncCursor(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    domain.SyncCursor
+		wantErr bool
+	}{
+		{value: "0", want: domain.SyncCursor{}},
+		{value: "7431.1842", want: domain.SyncCursor{TxID: 7431, Seq: 1842}},
+		{value: "1842", want: domain.SyncCursor{Seq: 1842}},
+		{value: "", wantErr: true},
+		{value: "7431.", wantErr: true},
+		{value: "-1.5", wantErr: true},
+		{value: "1.2.3", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := domain.ParseSyncCursor(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSyncCursor(%q) err = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSyncCursor(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+			if !tt.wantErr && !got.Legacy() {
+				if again, err := domain.ParseSyncCursor(got.String()); err != nil || again != got {
+					t.Errorf("round trip of %q = %+v, %v", got.String(), again, err)
+				}
+			}
+		})
+	}
+}
+
+func TestSyncCursorCompare(t *testing.T) {
+	older := domain.SyncCursor{TxID: 10, Seq: 900}
+	newer := domain.SyncCursor{TxID: 11, Seq: 5}
+	if older.Compare(newer) >= 0 || newer.Compare(older) <= 0 {
+		t.Errorf("cursors must order by transaction before sequence")
+	}
+	if !(domain.SyncCursor{Seq: 3}).Legacy() || newer.Legacy() || (domain.SyncCursor{}).Legacy() {
+		t.Errorf("Legacy() must be true only for sequence-only cursors")
+	}
+}
@@ -0,0 +1,31 @@
+package domain
+
+type TeamSearchFilter struct {
+	Query            string
+	HasActiveMembers *bool
+	MinSize          int
+}
+
+type TeamSearchResult struct {
+	Name          string
+	Members       int
+	ActiveMembers int
+	Score         float64
+}
+
+func NewTeamSearchFilter(query string, hasActiveMembers *bool, minSize int) (TeamSearchFilter, error) {
+	f := TeamSearchFilter{
+		Query:            NormalizeTeamName(query),
+		HasActiveMembers: hasActiveMembers,
+		MinSize:          minSize,
+	}
+	if f.Query != "" {
+		if err := validateText("query", f.Query, MaxTeamNameLength); err != nil {
+			return TeamSearchFilter{}, err
+		}
+	}
+	if f.MinSize < 0 {
+		return TeamSearchFilter{}, invalid("min_size", "must not be negative")
+	}
+	return f, nil
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+type TimelineEventKind string
+
+const (
+	TimelineCreated          TimelineEventKind = "created"
+	TimelineReviewerAssigned TimelineEventKind = "reviewer_assigned"
+	TimelineShadowAssigned   TimelineEventKind = "shadow_assigned"
+	TimelineReassigned       TimelineEventKind = "reviewer_reassigned"
+	TimelineDeclined         TimelineEventKind = "reviewer_declined"
+	TimelineFirstResponse    TimelineEventKind = "first_response"
+	TimelineConflictDeclared TimelineEventKind = "conflict_declared"
+	TimelineChecklistChecked TimelineEventKind = "checklist_checked"
+	TimelineReviewApproved   TimelineEventKind = "review_approved"
+	TimelineLinked           TimelineEventKind = "blocked_by_linked"
+	TimelineApprovalsReset   TimelineEventKind = "approvals_reset"
+	TimelineMerged           TimelineEventKind = "merged"
+	TimelineDeleted          TimelineEventKind = "deleted"
+)
+
+type TimelineEvent struct {
+	At         time.Time
+	Kind       TimelineEventKind
+	UserID     string
+	FromUserID string
+	Detail     string
+}
+
+type PullRequestTimeline struct {
+	PullRequestID string
+	Events        []TimelineEvent
+}
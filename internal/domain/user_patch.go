@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type UserField string
+
+const (
+	UserFieldUsername  UserField = "username"
+	UserFieldIsActive  UserField = "is_active"
+	UserFieldSeniority UserField = "seniority"
+	UserFieldTimezone  UserField = "timezone"
+)
+
+func (f UserField) Valid() bool {
+	switch f {
+	case UserFieldUsername, UserFieldIsActive, UserFieldSeniority, UserFieldTimezone:
+		return true
+	default:
+		return false
+	}
+}
+
+type UserPatch struct {
+	UserID          string
+	Mask            []UserField
+	Username        string
+	IsActive        bool
+	Seniority       Seniority
+	Timezone        string
+	ExpectedVersion *int64
+}
+
+func NewUserPatch(userID string, mask []string, username string, isActive *bool, seniority, timezone string, expectedVersion *int64) (UserPatch, error) {
+	p := UserPatch{UserID: NormalizeID(userID), ExpectedVersion: expectedVersion}
+	if err := validateText("user_id", p.UserID, MaxIDLength); err != nil {
+		return UserPatch{}, err
+	}
+	if len(mask) == 0 {
+		return UserPatch{}, invalid("update_mask", "must list at least one field")
+	}
+	for _, name := range mask {
+		field := UserField(strings.TrimSpace(name))
+		if !field.Valid() {
+			return UserPatch{}, invalid("update_mask", fmt.Sprintf("unknown field %q; must be one of username, is_active, seniority, timezone", name))
+		}
+		if !slices.Contains(p.Mask, field) {
+			p.Mask = append(p.Mask, field)
+		}
+	}
+	if expectedVersion != nil && *expectedVersion < 1 {
+		return UserPatch{}, invalid("version", "must be positive")
+	}
+
+	if p.Has(UserFieldUsername) {
+		p.Username = strings.TrimSpace(username)
+		if err := validateText("username", p.Username, MaxUsernameLength); err != nil {
+			return UserPatch{}, err
+		}
+	}
+	if p.Has(UserFieldIsActive) {
+		if isActive == nil {
+			return UserPatch{}, invalid("is_active", "is required when listed in update_mask")
+		}
+		p.IsActive = *isActive
+	}
+	if p.Has(UserFieldSeniority) {
+		p.Seniority = Seniority(seniority)
+		if !p.Seniority.Valid() {
+			return UserPatch{}, invalid("seniority", "must be one of junior, middle, senior")
+		}
+	}
+	if p.Has(UserFieldTimezone) && timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return UserPatch{}, invalid("timezone", "must be a known IANA timezone")
+		}
+		p.Timezone = timezone
+	}
+	return p, nil
+}
+
+func (p UserPatch) Has(field UserField) bool {
+	return slices.Contains(p.Mask, field)
+}
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MaxIDLength              = 128
+	MaxTeamNameLength        = 100
+	MaxUsernameLength        = 100
+	MaxPullRequestNameLength = 256
+	MaxHandoffNoteLength     = 1000
+	MaxRampUpDays            = 90
+	MaxAnnouncementLength    = 2000
+)
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+func invalid(field, message string) error {
+	return &ValidationError{Field: field, Message: message}
+}
+
+func NewTeam(name string, members []TeamMember) (Team, error) {
+	team := Team{Name: NormalizeTeamName(name)}
+	if err := validateText("team_name", team.Name, MaxTeamNameLength); err != nil {
+		return Team{}, err
+	}
+
+	team.Members = make([]TeamMember, 0, len(members))
+	seen := make(map[string]bool, len(members))
+	var duplicates []string
+	for _, m := range members {
+		member, err := NewTeamMember(m.UserID, m.Username, m.IsActive, m.Seniority)
+		if err != nil {
+			return Team{}, err
+		}
+		if seen[member.UserID] {
+			duplicates = append(duplicates, member.UserID)
+			continue
+		}
+		seen[member.UserID] = true
+		team.Members = append(team.Members, member)
+	}
+	if len(duplicates) > 0 {
+		return Team{}, invalid("members.user_id", "contains duplicates: "+strings.Join(duplicates, ", "))
+	}
+
+	return team, nil
+}
+
+func NewTeamMember(userID, username string, isActive bool, seniority Seniority) (TeamMember, error) {
+	member := TeamMember{
+		UserID:    NormalizeID(userID),
+		Username:  strings.TrimSpace(username),
+		IsActive:  isActive,
+		Seniority: seniority,
+	}
+	if err := validateText("members.user_id", member.UserID, MaxIDLength); err != nil {
+		return TeamMember{}, err
+	}
+	if err := validateText("members.username", member.Username, MaxUsernameLength); err != nil {
+		return TeamMember{}, err
+	}
+	if member.Seniority != "" && !member.Seniority.Valid() {
+		return TeamMember{}, invalid("members.seniority", "must be one of junior, middle, senior")
+	}
+	return member, nil
+}
+
+func NewPullRequest(id, name, authorID string) (PullRequest, error) {
+	pr := PullRequest{
+		ID:       NormalizeID(id),
+		Name:     strings.TrimSpace(name),
+		AuthorID: NormalizeID(authorID),
+		Status:   PullRequestStatusOpen,
+	}
+	if pr.ID != "" {
+		if err := validateText("pull_request_id", pr.ID, MaxIDLength); err != nil {
+			return PullRequest{}, err
+		}
+	}
+	if err := validateText("pull_request_name", pr.Name, MaxPullRequestNameLength); err != nil {
+		return PullRequest{}, err
+	}
+	if err := validateText("author_id", pr.AuthorID, MaxIDLength); err != nil {
+		return PullRequest{}, err
+	}
+	return pr, nil
+}
+
+func NewLinesChanged(lines *int) (*int, error) {
+	if lines != nil && *lines < 0 {
+		return nil, invalid("lines_changed", "must not be negative")
+	}
+	return lines, nil
+}
+
+func (pr PullRequest) ValidateStatus() error {
+	switch pr.Status {
+	case PullRequestStatusOpen:
+		if pr.MergedAt != nil || pr.MergedBy != nil {
+			return invalid("status", "OPEN pull request must not have merge details")
+		}
+	case PullRequestStatusMerged:
+		if pr.MergedAt == nil {
+			return invalid("status", "MERGED pull request must have mergedAt")
+		}
+	default:
+		return invalid("status", "must be one of OPEN, MERGED")
+	}
+	return nil
+}
+
+func NewHandoffNote(note string) (string, error) {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxHandoffNoteLength {
+		return "", invalid("note", fmt.Sprintf("must be at most %d characters", MaxHandoffNoteLength))
+	}
+	for _, r := range note {
+		if (unicode.IsControl(r) && r != '\n' && r != '\t') || r == utf8.RuneError {
+			return "", invalid("note", "must be valid text without control characters")
+		}
+	}
+	return note, nil
+}
+
+func ValidateRampUpDays(days int) error {
+	if days < 0 || days > MaxRampUpDays {
+		return invalid("days", fmt.Sprintf("must be between 0 and %d", MaxRampUpDays))
+	}
+	return nil
+}
+
+func NewAnnouncement(message string, severity AnnouncementSeverity, startsAt time.Time, endsAt *time.Time) (Announcement, error) {
+	a := Announcement{
+		Message:  strings.TrimSpace(message),
+		Severity: severity,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}
+	if a.Severity == "" {
+		a.Severity = AnnouncementSeverityInfo
+	}
+	if err := validateText("message", a.Message, MaxAnnouncementLength); err != nil {
+		return Announcement{}, err
+	}
+	if !a.Severity.Valid() {
+		return Announcement{}, invalid("severity", "must be one of info, warning, critical")
+	}
+	if a.EndsAt != nil && !a.EndsAt.After(a.StartsAt) {
+		return Announcement{}, invalid("ends_at", "must be after starts_at")
+	}
+	return a, nil
+}
+
+func validateText(field, value string, maxLength int) error {
+	if value == "" {
+		return invalid(field, "is required")
+	}
+	if utf8.RuneCountInString(value) > maxLength {
+		return invalid(field, fmt.Sprintf("must be at most %d characters", maxLength))
+	}
+	for _, r := range value {
+		if unicode.IsControl(r) || r == utf8.RuneError {
+			return invalid(field, "must be valid text without control characters")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/testfixtures"
+)
+
+func TestNewTeam(t *testing.T) {
+	t.Run("normalizes built team", func(t *testing.T) {
+		built := testfixtures.NewTeamBuilder().Named("  Backend  ").WithMembers(3).Inactive(1).Build()
+
+		team, err := domain.NewTeam(built.Name, built.Members)
+		if err != nil {
+			t.Fatalf("NewTeam: %v", err)
+		}
+		if team.Name != domain.NormalizeTeamName(built.Name) {
+			t.Errorf("Name = %q, want normalized %q", team.Name, domain.NormalizeTeamName(built.Name))
+		}
+		if len(team.Members) != 3 {
+			t.Fatalf("len(Members) = %d, want 3", len(team.Members))
+		}
+		if team.Members[2].IsActive {
+			t.Errorf("last member is active, want inactive")
+		}
+	})
+
+	t.Run("rejects duplicate user ids", func(t *testing.T) {
+		built := testfixtures.NewTeamBuilder().WithMembers(2).Build()
+		members := append(built.Members, built.Members[0])
+
+		_, err := domain.NewTeam(built.Name, members)
+		var verr *domain.ValidationError
+		if !errors.As(err, &verr) {
+			t.Fatalf("err = %v, want ValidationError", err)
+		}
+		if verr.Field != "members.user_id" || !strings.Contains(verr.Message, built.Members[0].UserID) {
+			t.Errorf("err = %v, want duplicate %s reported", verr, built.Members[0].UserID)
+		}
+	})
+
+	t.Run("rejects unknown seniority", func(t *testing.T) {
+		built := testfixtures.NewTeamBuilder().WithMembers(1).Build()
+		built.Members[0].Seniority = "principal"
+
+		_, err := domain.NewTeam(built.Name, built.Members)
+		var verr *domain.ValidationError
+		if !errors.As(err, &verr) || verr.Field != "members.seniority" {
+			t.Errorf("err = %v, want members.seniority validation error", err)
+		}
+	})
+}
+
+func TestPullRequestValidateStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		pr      domain.PullRequest
+		wantErr bool
+	}{
+		{
+			name: "open",
+			pr:   testfixtures.NewPullRequestBuilder().WithReviewers("backend-u2").Build(),
+		},
+		{
+			name: "merged",
+			pr:   testfixtures.NewPullRequestBuilder().Merged(testfixtures.BaseTime, "backend-u1").Build(),
+		},
+		{
+			name: "open with merge details",
+			pr: func() domain.PullRequest {
+				pr := testfixtures.NewPullRequestBuilder().Build()
+				pr.MergedAt = &testfixtures.BaseTime
+				return pr
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "merged without timestamp",
+			pr: func() domain.PullRequest {
+				pr := testfixtures.NewPullRequestBuilder().Merged(testfixtures.BaseTime, "").Build()
+				pr.MergedAt = nil
+				return pr
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pr.ValidateStatus()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStatus() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPullRequestApprovals(t *testing.T) {
+	pr := testfixtures.NewPullRequestBuilder().
+		WithReviewers("backend-u2", "backend-u3").
+		WithShadows("backend-u4").
+		Build()
+	if got := pr.Approvals(); got != 0 {
+		t.Fatalf("Approvals() = %d, want 0", got)
+	}
+
+	for i := range pr.Assignments {
+		pr.Assignments[i].ApprovedAt = &testfixtures.BaseTime
+	}
+	if got := pr.Approvals(); got != 2 {
+		t.Errorf("Approvals() = %d, want 2 (shadow approvals do not count)", got)
+	}
+}
+
+func FuzzNewTeam(f *testing.F) {
+	f.Add("backend", "u1", "Alice", "u2", "Bob", "senior")
+	f.Add("  Backend́ ", "u1", "Alice", "u1", "Alice", "")
+	f.Add("", "", "", "\x00", "\xff", "principal")
+	f.Fuzz(func(t *testing.T, name, id1, username1, id2, username2, seniority string) {
+		members := []domain.TeamMember{
+			{UserID: id1, Username: username1, IsActive: true, Seniority: domain.Seniority(seniority)},
+			{UserID: id2, Username: username2},
+		}
+		team, err := domain.NewTeam(name, members)
+		if err != nil {
+			var verr *domain.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("NewTeam returned %T, want ValidationError", err)
+			}
+			return
+		}
+		if team.Name != domain.NormalizeTeamName(name) || !utf8.ValidString(team.Name) {
+			t.Errorf("Name = %q is not the normalized input", team.Name)
+		}
+		if utf8.RuneCountInString(team.Name) > domain.MaxTeamNameLength {
+			t.Errorf("Name has %d runes, over the limit", utf8.RuneCountInString(team.Name))
+		}
+		if len(team.Members) != 2 || team.Members[0].UserID == team.Members[1].UserID {
+			t.Errorf("Members = %+v, want two distinct members", team.Members)
+		}
+		again, err := domain.NewTeam(team.Name, team.Members)
+		if err != nil || again.Name != team.Name {
+			t.Errorf("NewTeam is not idempotent: %v", err)
+		}
+	})
+}
+
+func FuzzNewPullRequest(f *testing.F) {
+	f.Add("pr-1", "Add feature", "u1", 10)
+	f.Add("", "  ", "u1", -1)
+	f.Add("pr-Å", "name\x7f", "�", 0)
+	f.Fuzz(func(t *testing.T, id, name, authorID string, lines int) {
+		pr, err := domain.NewPullRequest(id, name, authorID)
+		if err == nil {
+			if pr.Name == "" || pr.AuthorID == "" || !utf8.ValidString(pr.Name) {
+				t.Errorf("accepted invalid pull request %+v", pr)
+			}
+			if utf8.RuneCountInString(pr.Name) > domain.MaxPullRequestNameLength {
+				t.Errorf("Name has %d runes, over the limit", utf8.RuneCountInString(pr.Name))
+			}
+			if _, err := domain.NewPullRequest(pr.ID, pr.Name, pr.AuthorID); err != nil {
+				t.Errorf("NewPullRequest is not idempotent: %v", err)
+			}
+		}
+
+		got, err := domain.NewLinesChanged(&lines)
+		if (err != nil) != (lines < 0) {
+			t.Errorf("NewLinesChanged(%d) err = %v", lines, err)
+		}
+		if err == nil && *got != lines {
+			t.Errorf("NewLinesChanged(%d) = %d", lines, *got)
+		}
+	})
+}
+
+func FuzzReassignInput(f *testing.F) {
+	f.Add("overloaded", "", true)
+	f.Add("other", "", false)
+	f.Add("", "handing over\n\tcontext", true)
+	f.Add("on_leave", "\x00\xff", false)
+	f.Fuzz(func(t *testing.T, reason, note string, required bool) {
+		handoff, noteErr := domain.NewHandoffNote(note)
+		if noteErr == nil {
+			if !utf8.ValidString(handoff) || utf8.RuneCountInString(handoff) > domain.MaxHandoffNoteLength {
+				t.Errorf("accepted invalid note %q", handoff)
+			}
+			if handoff != strings.TrimSpace(handoff) {
+				t.Errorf("note %q is not trimmed", handoff)
+			}
+		}
+
+		got, err := domain.NewDeclineReason(domain.DeclineReason(reason), handoff, required)
+		if err != nil {
+			return
+		}
+		switch {
+		case got == "" && (required || reason != ""):
+			t.Errorf("NewDeclineReason(%q, required=%v) accepted an empty reason", reason, required)
+		case got != "" && !got.Valid():
+			t.Errorf("NewDeclineReason accepted unknown reason %q", got)
+		case got == domain.DeclineOther && handoff == "":
+			t.Errorf("reason other accepted without a note")
+		}
+	})
+}
+
+func TestPullRequestStatusSummaryReviewComplete(t *testing.T) {
+	reviewers := []string{"backend-u2", "backend-u3"}
+	tests := []struct {
+		name    string
+		summary domain.PullRequestStatusSummary
+		want    bool
+	}{
+		{
+			name:    "no reviewers",
+			summary: domain.PullRequestStatusSummary{QuorumMet: true},
+		},
+		{
+			name:    "all responded without approval policy",
+			summary: domain.PullRequestStatusSummary{Reviewers: reviewers, Responded: reviewers, QuorumMet: true},
+			want:    true,
+		},
+		{
+			name:    "responded but quorum not met",
+			summary: domain.PullRequestStatusSummary{Reviewers: reviewers, Responded: reviewers},
+		},
+		{
+			name: "responded without required approvals",
+			summary: domain.PullRequestStatusSummary{
+				Reviewers: reviewers, Responded: reviewers, Approvals: 1, RequiredApprovals: 2, QuorumMet: true,
+			},
+		},
+		{
+			name: "required approvals capped by reviewer count",
+			summary: domain.PullRequestStatusSummary{
+				Reviewers: reviewers, Approvals: 2, RequiredApprovals: 3, QuorumMet: true,
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.summary.ReviewComplete(); got != tt.want {
+				t.Errorf("ReviewComplete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
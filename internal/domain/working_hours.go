@@ -0,0 +1,85 @@
+package domain
+
+import "time"
+
+const (
+	DefaultWorkStart = 9 * time.Hour
+	DefaultWorkEnd   = 18 * time.Hour
+)
+
+type WorkingHours struct {
+	UserID   string
+	Timezone string
+	Start    time.Duration
+	End      time.Duration
+}
+
+func NewWorkingHours(userID, timezone string, start, end time.Duration) (WorkingHours, error) {
+	w := WorkingHours{UserID: NormalizeID(userID)}
+	if err := validateText("user_id", w.UserID, MaxIDLength); err != nil {
+		return WorkingHours{}, err
+	}
+	if timezone == "" {
+		return w, nil
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return WorkingHours{}, invalid("timezone", "must be a known IANA timezone")
+	}
+	if start < 0 || end > 24*time.Hour || start >= end {
+		return WorkingHours{}, invalid("start", "must satisfy 00:00 <= start < end <= 24:00")
+	}
+	w.Timezone, w.Start, w.End = timezone, start, end
+	return w, nil
+}
+
+func (w WorkingHours) Known() bool {
+	return w.Timezone != ""
+}
+
+func (w WorkingHours) Overlap(other WorkingHours, at time.Time) time.Duration {
+	if !w.Known() || !other.Known() {
+		return 0
+	}
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return 0
+	}
+	otherLoc, err := time.LoadLocation(other.Timezone)
+	if err != nil {
+		return 0
+	}
+
+	local := at.In(loc)
+	start, end := w.on(local.Year(), local.Month(), local.Day(), loc)
+
+	var total time.Duration
+	theirs := at.In(otherLoc)
+	for d := -1; d <= 1; d++ {
+		otherStart, otherEnd := other.on(theirs.Year(), theirs.Month(), theirs.Day()+d, otherLoc)
+		if overlap := minTime(end, otherEnd).Sub(maxTime(start, otherStart)); overlap > 0 {
+			total += overlap
+		}
+	}
+	return total
+}
+
+func (w WorkingHours) on(year int, month time.Month, day int, loc *time.Location) (time.Time, time.Time) {
+	clock := func(offset time.Duration) time.Time {
+		return time.Date(year, month, day, int(offset/time.Hour), int((offset%time.Hour)/time.Minute), 0, 0, loc)
+	}
+	return clock(w.Start), clock(w.End)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
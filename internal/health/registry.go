@@ -0,0 +1,94 @@
+package health
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+type Probe func(ctx context.Context) error
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type Result struct {
+	Name     string
+	Critical bool
+	Err      error
+	Duration time.Duration
+}
+
+type Report struct {
+	Ready        bool
+	Dependencies []Result
+}
+
+type dependency struct {
+	name     string
+	critical bool
+	probe    Probe
+}
+
+type Registry struct {
+	timeout  time.Duration
+	critical map[string]bool
+
+	mu   sync.RWMutex
+	deps []dependency
+}
+
+func NewRegistry(timeout time.Duration, critical []string) *Registry {
+	r := &Registry{timeout: timeout, critical: make(map[string]bool, len(critical))}
+	for _, name := range critical {
+		r.critical[name] = true
+	}
+	return r
+}
+
+func (r *Registry) Register(name string, probe Probe) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deps = append(r.deps, dependency{name: name, critical: r.critical[name], probe: probe})
+}
+
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.deps))
+	for _, d := range r.deps {
+		names = append(names, d.name)
+	}
+	return names
+}
+
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.RLock()
+	deps := slices.Clone(r.deps)
+	r.mu.RUnlock()
+
+	results := make([]Result, len(deps))
+	var wg sync.WaitGroup
+	for i, d := range deps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, r.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := d.probe(probeCtx)
+			results[i] = Result{Name: d.name, Critical: d.critical, Err: err, Duration: time.Since(start)}
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Ready: true, Dependencies: results}
+	for _, res := range results {
+		if res.Critical && res.Err != nil {
+			report.Ready = false
+		}
+	}
+	return report
+}
@@ -0,0 +1,239 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+const (
+	defaultRetryBaseDelay   = 200 * time.Millisecond
+	defaultRetryMaxDelay    = 5 * time.Second
+	defaultRetryBudget      = 0.2
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+	maxDrainBytes           = 64 << 10
+)
+
+type Config struct {
+	Timeout          time.Duration
+	MaxRetries       int
+	RetryBaseDelay   time.Duration
+	RetryMaxDelay    time.Duration
+	RetryBudget      float64
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+type Stats struct {
+	Name           string
+	Requests       int64
+	Failures       int64
+	Retries        int64
+	RetriesDropped int64
+	Rejected       int64
+	BreakerOpen    bool
+}
+
+type Registry struct {
+	proxy func(*http.Request) (*url.URL, error)
+
+	mu      sync.Mutex
+	clients []*transport
+}
+
+func NewRegistry(proxy func() string) (*Registry, error) {
+	if _, err := parseProxy(proxy()); err != nil {
+		return nil, err
+	}
+	r := &Registry{proxy: func(req *http.Request) (*url.URL, error) {
+		proxyURL, err := parseProxy(proxy())
+		if err != nil {
+			return nil, err
+		}
+		if proxyURL == nil {
+			return http.ProxyFromEnvironment(req)
+		}
+		return proxyURL, nil
+	}}
+	return r, nil
+}
+
+func parseProxy(proxy string) (*url.URL, error) {
+	if proxy == "" {
+		return nil, nil
+	}
+	proxyURL, err := url.Parse(proxy)
+	if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid outbound proxy")
+	}
+	return proxyURL, nil
+}
+
+func (r *Registry) Client(name string, cfg Config) *http.Client {
+	if cfg.RetryBaseDelay <= 0 {
+		cfg.RetryBaseDelay = defaultRetryBaseDelay
+	}
+	if cfg.RetryMaxDelay <= 0 {
+		cfg.RetryMaxDelay = defaultRetryMaxDelay
+	}
+	if cfg.RetryBudget <= 0 {
+		cfg.RetryBudget = defaultRetryBudget
+	}
+	if cfg.BreakerThreshold <= 0 {
+		cfg.BreakerThreshold = defaultBreakerThreshold
+	}
+	if cfg.BreakerCooldown <= 0 {
+		cfg.BreakerCooldown = defaultBreakerCooldown
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.Proxy = r.proxy
+	t := &transport{
+		name:    name,
+		cfg:     cfg,
+		base:    base,
+		budget:  newRetryBudget(cfg.RetryBudget),
+		breaker: &breaker{threshold: cfg.BreakerThreshold, cooldown: cfg.BreakerCooldown},
+		now:     time.Now,
+	}
+
+	r.mu.Lock()
+	r.clients = append(r.clients, t)
+	r.mu.Unlock()
+	return &http.Client{Transport: t, Timeout: cfg.Timeout}
+}
+
+func (r *Registry) Stats() []Stats {
+	r.mu.Lock()
+	clients := slices.Clone(r.clients)
+	r.mu.Unlock()
+
+	stats := make([]Stats, 0, len(clients))
+	for _, t := range clients {
+		stats = append(stats, Stats{
+			Name:           t.name,
+			Requests:       t.requests.Load(),
+			Failures:       t.failures.Load(),
+			Retries:        t.retries.Load(),
+			RetriesDropped: t.retriesDropped.Load(),
+			Rejected:       t.rejected.Load(),
+			BreakerOpen:    t.breaker.open(t.now()),
+		})
+	}
+	return stats
+}
+
+type transport struct {
+	name    string
+	cfg     Config
+	base    http.RoundTripper
+	budget  *retryBudget
+	breaker *breaker
+	now     func() time.Time
+
+	requests       atomic.Int64
+	failures       atomic.Int64
+	retries        atomic.Int64
+	retriesDropped atomic.Int64
+	rejected       atomic.Int64
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.breaker.allow(t.now()) {
+		t.rejected.Add(1)
+		return nil, fmt.Errorf("%s: %w", t.name, ErrCircuitOpen)
+	}
+	t.requests.Add(1)
+	t.budget.deposit()
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		failed := err != nil || resp.StatusCode >= http.StatusInternalServerError
+		if !failed && resp.StatusCode != http.StatusTooManyRequests {
+			t.breaker.success()
+			return resp, nil
+		}
+
+		if attempt >= t.cfg.MaxRetries || !replayable(req) || req.Context().Err() != nil {
+			t.finish(failed)
+			return resp, err
+		}
+		if !t.budget.withdraw() {
+			t.retriesDropped.Add(1)
+			t.finish(failed)
+			return resp, err
+		}
+		t.retries.Add(1)
+		if resp != nil {
+			_, _ = io.CopyN(io.Discard, resp.Body, maxDrainBytes)
+			resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), t.backoff(attempt)); err != nil {
+			t.finish(true)
+			return nil, err
+		}
+		if req, err = rewind(req); err != nil {
+			t.finish(true)
+			return nil, err
+		}
+	}
+}
+
+func (t *transport) finish(failed bool) {
+	if failed {
+		t.failures.Add(1)
+		t.breaker.failure(t.now())
+		return
+	}
+	t.breaker.success()
+}
+
+func (t *transport) backoff(attempt int) time.Duration {
+	delay := min(t.cfg.RetryBaseDelay<<attempt, t.cfg.RetryMaxDelay)
+	return delay/2 + rand.N(delay/2+1)
+}
+
+func replayable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	default:
+		return false
+	}
+}
+
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("rewind request body: %w", err)
+	}
+	next := req.Clone(req.Context())
+	next.Body = body
+	return next, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+package httpserver
+
+import (
+	"net/http"
+)
+
+func (h *handler) handleAdminAbsenceSync(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "absence sync report is available only for trusted callers")
+		return
+	}
+
+	report, ok := h.admin.LastAbsenceSync()
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "absence sync has not run yet")
+		return
+	}
+
+	issues := make([]map[string]any, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, map[string]any{
+			"event_uid": issue.EventUID,
+			"message":   issue.Message,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"ran_at":      formatTime(report.RanAt),
+		"events":      report.Events,
+		"absences":    report.Absences,
+		"cancelled":   report.Cancelled,
+		"deactivated": report.Deactivated,
+		"restored":    report.Restored,
+		"issues":      issues,
+	})
+}
@@ -0,0 +1,76 @@
+package httpserver
+
+import "net/http"
+
+func (h *handler) handleAdminConsistency(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "consistency report is available only for trusted callers")
+		return
+	}
+
+	violations, err := h.admin.CheckConsistency(r.Context())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	result := make([]map[string]any, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, map[string]any{
+			"check":         v.Check,
+			"subject":       v.Subject,
+			"detail":        v.Detail,
+			"suggested_fix": v.SuggestedFix,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"consistent": len(violations) == 0,
+		"violations": result,
+	})
+}
+
+func (h *handler) handleAdminDBStats(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "database statistics are available only for trusted callers")
+		return
+	}
+
+	stats, err := h.admin.GetDBStats(r.Context())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	tables := make([]map[string]any, 0, len(stats.Tables))
+	for _, t := range stats.Tables {
+		table := map[string]any{
+			"table":                  t.Name,
+			"live_rows":              t.LiveRows,
+			"dead_rows":              t.DeadRows,
+			"modified_since_analyze": t.ModifiedSinceAnalyze,
+			"total_bytes":            t.TotalBytes,
+			"table_bytes":            t.TableBytes,
+		}
+		if t.LastAnalyzedAt != nil {
+			table["last_analyzed_at"] = formatTime(*t.LastAnalyzedAt)
+		}
+		tables = append(tables, table)
+	}
+
+	indexes := make([]map[string]any, 0, len(stats.Indexes))
+	for _, idx := range stats.Indexes {
+		indexes = append(indexes, map[string]any{
+			"index":                 idx.Name,
+			"table":                 idx.Table,
+			"bytes":                 idx.Bytes,
+			"estimated_bloat_bytes": idx.EstimatedBloatBytes,
+			"scans":                 idx.Scans,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"tables":  tables,
+		"indexes": indexes,
+	})
+}
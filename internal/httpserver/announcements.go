@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleAdminAnnouncementsList(w http.ResponseWriter, r *http.Request) {
+	announcements, err := h.admin.ListAnnouncements(r.Context())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	result := make([]map[string]any, 0, len(announcements))
+	for _, a := range announcements {
+		result = append(result, mapAnnouncement(a))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"announcements": result,
+	})
+}
+
+func (h *handler) handleAdminAnnouncementsCreate(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "publishing announcements is allowed only for trusted callers")
+		return
+	}
+
+	var req struct {
+		Message  string     `json:"message"`
+		Severity string     `json:"severity"`
+		StartsAt *time.Time `json:"starts_at"`
+		EndsAt   *time.Time `json:"ends_at"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	announcement, err := h.admin.CreateAnnouncement(r.Context(), req.Message, domain.AnnouncementSeverity(req.Severity), req.StartsAt, req.EndsAt)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"announcement": mapAnnouncement(announcement),
+	})
+}
+
+func mapAnnouncement(a domain.Announcement) map[string]any {
+	resp := map[string]any{
+		"announcement_id": a.ID,
+		"message":         a.Message,
+		"severity":        string(a.Severity),
+		"starts_at":       formatTime(a.StartsAt),
+		"created_at":      formatTime(a.CreatedAt),
+	}
+	if a.EndsAt != nil {
+		resp["ends_at"] = formatTime(*a.EndsAt)
+	}
+	return resp
+}
@@ -0,0 +1,31 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+)
+
+func (h *handler) handlePullRequestInvalidateApprovals(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID string `json:"pull_request_id"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.ID == "" {
+		writeValidationError(w, errors.New("pull_request_id is required"))
+		return
+	}
+
+	pr, reset, err := h.pullRequests.InvalidateApprovals(r.Context(), req.ID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pr":              mapPullRequest(pr),
+		"reset_reviewers": reset,
+	})
+}
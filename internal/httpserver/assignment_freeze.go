@@ -0,0 +1,104 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleAdminFreezesList(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "listing assignment freezes is allowed only for trusted callers")
+		return
+	}
+
+	freezes, err := h.admin.ListAssignmentFreezes(r.Context())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	result := make([]map[string]any, 0, len(freezes))
+	for _, f := range freezes {
+		result = append(result, mapAssignmentFreeze(f))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"freezes": result,
+	})
+}
+
+func (h *handler) handleAdminFreezesCreate(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "scheduling assignment freezes is allowed only for trusted callers")
+		return
+	}
+
+	var req struct {
+		Reason   string     `json:"reason"`
+		StartsAt *time.Time `json:"starts_at"`
+		EndsAt   *time.Time `json:"ends_at"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.EndsAt == nil {
+		writeValidationError(w, errors.New("ends_at is required"))
+		return
+	}
+
+	freeze, err := h.admin.CreateAssignmentFreeze(r.Context(), req.Reason, req.StartsAt, *req.EndsAt)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"freeze": mapAssignmentFreeze(freeze),
+	})
+}
+
+func (h *handler) handleAdminFreezesLift(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "lifting assignment freezes is allowed only for trusted callers")
+		return
+	}
+
+	var req struct {
+		FreezeID int64 `json:"freeze_id"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.FreezeID <= 0 {
+		writeValidationError(w, errors.New("freeze_id is required"))
+		return
+	}
+
+	freeze, err := h.admin.LiftAssignmentFreeze(r.Context(), req.FreezeID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"freeze": mapAssignmentFreeze(freeze),
+	})
+}
+
+func mapAssignmentFreeze(f domain.AssignmentFreeze) map[string]any {
+	resp := map[string]any{
+		"freeze_id":  f.ID,
+		"reason":     f.Reason,
+		"starts_at":  formatTime(f.StartsAt),
+		"ends_at":    formatTime(f.EndsAt),
+		"created_at": formatTime(f.CreatedAt),
+	}
+	if f.LiftedAt != nil {
+		resp["lifted_at"] = formatTime(*f.LiftedAt)
+	}
+	return resp
+}
@@ -0,0 +1,33 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleTeamAssignmentStrategy(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName string  `json:"team_name"`
+		Strategy *string `json:"strategy"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.TeamName == "" || req.Strategy == nil {
+		writeValidationError(w, errors.New("team_name and strategy are required"))
+		return
+	}
+
+	team, err := h.teams.SetTeamAssignmentStrategy(r.Context(), req.TeamName, domain.AssignmentStrategyKind(*req.Strategy))
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team": mapTeam(team),
+	})
+}
@@ -0,0 +1,95 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleTeamCalendarGet(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+
+	cal, err := h.teams.GetTeamCalendar(r.Context(), teamName)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapTeamCalendar(cal))
+}
+
+func (h *handler) handleTeamCalendarSet(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName  string   `json:"team_name"`
+		Timezone  string   `json:"timezone"`
+		WorkStart string   `json:"work_start"`
+		WorkEnd   string   `json:"work_end"`
+		Workdays  []int    `json:"workdays"`
+		Holidays  []string `json:"holidays"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.TeamName == "" || req.Timezone == "" || req.WorkStart == "" || req.WorkEnd == "" {
+		writeValidationError(w, errors.New("team_name, timezone, work_start and work_end are required"))
+		return
+	}
+
+	start, err := domain.ParseClock(req.WorkStart)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	end, err := domain.ParseClock(req.WorkEnd)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	cal := domain.Calendar{
+		Timezone:  req.Timezone,
+		WorkStart: start,
+		WorkEnd:   end,
+		Holidays:  req.Holidays,
+	}
+	for _, d := range req.Workdays {
+		cal.Workdays = append(cal.Workdays, time.Weekday(d))
+	}
+
+	teamCal, err := h.teams.SetTeamCalendar(r.Context(), req.TeamName, cal)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapTeamCalendar(teamCal))
+}
+
+func mapTeamCalendar(tc domain.TeamCalendar) map[string]any {
+	workdays := make([]int, 0, len(tc.Calendar.Workdays))
+	for _, d := range tc.Calendar.Workdays {
+		workdays = append(workdays, int(d))
+	}
+	holidays := tc.Calendar.Holidays
+	if holidays == nil {
+		holidays = []string{}
+	}
+
+	return map[string]any{
+		"team_name":  tc.TeamName,
+		"timezone":   tc.Calendar.Timezone,
+		"work_start": domain.FormatClock(tc.Calendar.WorkStart),
+		"work_end":   domain.FormatClock(tc.Calendar.WorkEnd),
+		"workdays":   workdays,
+		"holidays":   holidays,
+		"is_default": tc.IsDefault,
+	}
+}
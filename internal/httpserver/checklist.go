@@ -0,0 +1,111 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleTeamChecklist(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName        string   `json:"team_name"`
+		Items           []string `json:"items"`
+		RequireForMerge bool     `json:"require_for_merge"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.TeamName == "" {
+		writeValidationError(w, errors.New("team_name is required"))
+		return
+	}
+
+	seen := make(map[string]bool, len(req.Items))
+	for _, item := range req.Items {
+		if item == "" {
+			writeValidationError(w, errors.New("items must not be empty"))
+			return
+		}
+		if seen[item] {
+			writeValidationError(w, errors.New("items must be unique"))
+			return
+		}
+		seen[item] = true
+	}
+	if req.Items == nil {
+		req.Items = []string{}
+	}
+
+	team, err := h.teams.SetTeamChecklist(r.Context(), req.TeamName, req.Items, req.RequireForMerge)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team": mapTeam(team),
+	})
+}
+
+func (h *handler) handlePullRequestChecklist(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID         string `json:"pull_request_id"`
+		ReviewerID string `json:"reviewer_id"`
+		ItemID     int64  `json:"item_id"`
+		Checked    *bool  `json:"checked"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.ID == "" || req.ReviewerID == "" || req.ItemID == 0 {
+		writeValidationError(w, errors.New("pull_request_id, reviewer_id and item_id are required"))
+		return
+	}
+	checked := true
+	if req.Checked != nil {
+		checked = *req.Checked
+	}
+
+	pr, err := h.pullRequests.SetChecklistItem(r.Context(), req.ID, req.ReviewerID, req.ItemID, checked)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pr": mapPullRequest(pr),
+	})
+}
+
+func mapChecklist(items []domain.ChecklistItem) []map[string]any {
+	result := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		result = append(result, map[string]any{
+			"item_id": item.ID,
+			"title":   item.Title,
+		})
+	}
+	return result
+}
+
+func mapChecklistState(items []domain.ChecklistItemState) []map[string]any {
+	result := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		resp := map[string]any{
+			"item_id": item.ItemID,
+			"title":   item.Title,
+			"checked": item.CheckedBy != nil,
+		}
+		if item.CheckedBy != nil {
+			resp["checked_by"] = *item.CheckedBy
+		}
+		if item.CheckedAt != nil {
+			resp["checkedAt"] = formatTime(*item.CheckedAt)
+		}
+		result = append(result, resp)
+	}
+	return result
+}
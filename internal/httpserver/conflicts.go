@@ -0,0 +1,89 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpserver/pagination"
+)
+
+var conflictListOptions = pagination.Options{
+	DefaultLimit: 100,
+	MaxLimit:     500,
+	SortFields:   []string{"declared_at"},
+	DefaultSort:  "-declared_at",
+}
+
+func (h *handler) handlePullRequestDeclareConflict(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID         string `json:"pull_request_id"`
+		ReviewerID string `json:"reviewer_id"`
+		Category   string `json:"category"`
+		Details    string `json:"details"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.ID == "" || req.ReviewerID == "" || req.Category == "" {
+		writeValidationError(w, errors.New("pull_request_id, reviewer_id and category are required"))
+		return
+	}
+
+	pr, conflict, err := h.pullRequests.DeclareConflict(r.Context(), req.ID, req.ReviewerID, domain.ConflictCategory(req.Category), req.Details)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pr":          mapPullRequest(pr),
+		"replaced_by": conflict.ReplacedBy,
+		"conflict":    mapConflict(conflict),
+	})
+}
+
+func (h *handler) handleAdminConflicts(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "conflict declarations are available only for trusted callers")
+		return
+	}
+
+	params, err := pagination.Parse(r.URL.Query(), conflictListOptions)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	conflicts, err := h.admin.ListReviewerConflicts(r.Context(), params.Page())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+	conflicts, next := pagination.Trim(params, conflicts)
+
+	items := make([]map[string]any, 0, len(conflicts))
+	for _, c := range conflicts {
+		items = append(items, mapConflict(c))
+	}
+	resp := map[string]any{"conflicts": items}
+	if next != "" {
+		resp["next_cursor"] = next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func mapConflict(c domain.ConflictDeclaration) map[string]any {
+	item := map[string]any{
+		"pull_request_id": c.PullRequestID,
+		"reviewer_id":     c.ReviewerID,
+		"category":        c.Category,
+		"replaced_by":     c.ReplacedBy,
+		"declared_at":     formatTime(c.DeclaredAt),
+	}
+	if c.Details != "" {
+		item["details"] = c.Details
+	}
+	return item
+}
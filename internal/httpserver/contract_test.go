@@ -0,0 +1,284 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	openapi "github.com/bubelovv/avito-internship-autumn-2025"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/replica"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/service"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"go.uber.org/zap"
+)
+
+const contractToken = "contract-token"
+
+var contractTime = time.Date(2025, time.October, 1, 10, 0, 0, 0, time.UTC)
+
+var contractMembers = []domain.TeamMember{
+	{UserID: "u1", Username: "Alice", IsActive: true, Seniority: domain.SenioritySenior},
+	{UserID: "u2", Username: "Bob", IsActive: true, Seniority: domain.SeniorityMiddle},
+	{UserID: "u3", Username: "Carol", IsActive: false, Seniority: domain.SeniorityJunior},
+}
+
+func contractPullRequest(id string) domain.PullRequest {
+	return domain.PullRequest{
+		ID:        id,
+		Name:      "Add search",
+		AuthorID:  "u1",
+		Status:    domain.PullRequestStatusOpen,
+		CreatedAt: contractTime,
+		Reviewers: []string{"u2"},
+		Shadows:   []string{},
+		Assignments: []domain.ReviewerAssignment{
+			{ReviewerID: "u2", Kind: domain.AssignmentKindRegular, AssignedAt: contractTime},
+		},
+	}
+}
+
+type contractTeams struct{ TeamService }
+
+func (contractTeams) CreateTeam(_ context.Context, teamName string, members []domain.TeamMember, _ *int) (domain.Team, error) {
+	if teamName == "exists" {
+		return domain.Team{}, service.ErrTeamExists
+	}
+	for i := range members {
+		if members[i].Seniority == "" {
+			members[i].Seniority = domain.SeniorityMiddle
+		}
+	}
+	return domain.Team{ID: 1, Name: teamName, Members: members}, nil
+}
+
+func (contractTeams) UpdateTeam(_ context.Context, teamName string, reviewersRequired *int) (domain.Team, int, error) {
+	reviewers := 2
+	if reviewersRequired != nil {
+		reviewers = *reviewersRequired
+	}
+	return domain.Team{ID: 1, Name: teamName}, reviewers, nil
+}
+
+func (contractTeams) GetTeamOverview(_ context.Context, teamName string) (domain.Team, domain.TeamMemberCounts, error) {
+	if teamName != "backend" {
+		return domain.Team{}, domain.TeamMemberCounts{}, service.ErrTeamNotFound
+	}
+	return domain.Team{ID: 1, Name: teamName}, domain.TeamMemberCounts{Total: len(contractMembers), Active: 2}, nil
+}
+
+func (contractTeams) ListTeamMembers(context.Context, int64, domain.Page) ([]domain.TeamMember, error) {
+	return contractMembers, nil
+}
+
+func (contractTeams) StreamTeamMembers(_ context.Context, _ int64, fn func(domain.TeamMember) error) error {
+	for _, m := range contractMembers {
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type contractUsers struct{ UserService }
+
+func (contractUsers) SetUserActivity(_ context.Context, userID string, isActive bool) (domain.User, error) {
+	if userID == "missing" {
+		return domain.User{}, service.ErrUserNotFound
+	}
+	teamName := "backend"
+	return domain.User{ID: userID, Username: "Bob", IsActive: isActive, Seniority: domain.SeniorityMiddle, TeamName: &teamName, Version: 2}, nil
+}
+
+func (contractUsers) ListReviewerPullRequests(_ context.Context, userID string, _ domain.Page, _ bool) ([]domain.PullRequestShort, error) {
+	return []domain.PullRequestShort{
+		{ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: domain.PullRequestStatusOpen},
+	}, nil
+}
+
+type contractPullRequests struct{ PullRequestService }
+
+func (contractPullRequests) CreatePullRequest(_ context.Context, prID, prName, _ string, _ *int) (domain.PullRequest, error) {
+	if prID == "pr-exists" {
+		return domain.PullRequest{}, service.ErrPullRequestExists
+	}
+	pr := contractPullRequest(prID)
+	pr.Name = prName
+	return pr, nil
+}
+
+func (contractPullRequests) MergePullRequest(_ context.Context, prID string, _ domain.MergeActor) (domain.PullRequest, error) {
+	if prID == "missing" {
+		return domain.PullRequest{}, service.ErrPullRequestNotFound
+	}
+	pr := contractPullRequest(prID)
+	merged := contractTime.Add(time.Hour)
+	pr.Status, pr.MergedAt = domain.PullRequestStatusMerged, &merged
+	return pr, nil
+}
+
+func (contractPullRequests) ReassignReviewer(_ context.Context, prID, oldReviewerID string, _ domain.DeclineReason, _ string) (domain.PullRequest, string, error) {
+	if oldReviewerID != "u2" {
+		return domain.PullRequest{}, "", service.ErrReviewerNotAssigned
+	}
+	pr := contractPullRequest(prID)
+	pr.Reviewers = []string{"u4"}
+	pr.Assignments[0].ReviewerID = "u4"
+	return pr, "u4", nil
+}
+
+func newContractServer(t *testing.T) http.Handler {
+	t.Helper()
+	h := &handler{
+		teams:        contractTeams{},
+		users:        contractUsers{},
+		pullRequests: contractPullRequests{},
+		logger:       zap.NewNop(),
+		trustedToken: func() string { return contractToken },
+		shedder:      newLoadShedder(LoadShedConfig{}),
+		replica:      replica.NewState("", replica.RolePrimary),
+
+		readConsistency: readConsistencyStrong,
+	}
+	return h.routes()
+}
+
+func loadContract(t *testing.T) routers.Router {
+	t.Helper()
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(openapi.Spec)
+	if err != nil {
+		t.Fatalf("load openapi.yaml: %v", err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		t.Fatalf("openapi.yaml is invalid: %v", err)
+	}
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		t.Fatalf("build router from openapi.yaml: %v", err)
+	}
+	return router
+}
+
+// TestResponsesMatchContract sends real requests through the router, backed by
+// in-memory services, and checks every response against openapi.yaml,
+// including that its status code is documented for the operation.
+func TestResponsesMatchContract(t *testing.T) {
+	contract := loadContract(t)
+	server := newContractServer(t)
+
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		body    string
+		trusted bool
+		status  int
+	}{
+		{name: "health", method: http.MethodGet, target: "/health", status: http.StatusOK},
+		{name: "version", method: http.MethodGet, target: "/version", status: http.StatusOK},
+		{name: "replica role", method: http.MethodGet, target: "/health/role", status: http.StatusOK},
+
+		{name: "create team", method: http.MethodPost, target: "/team/add",
+			body:   `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`,
+			status: http.StatusCreated},
+		{name: "create team dry run", method: http.MethodPost, target: "/team/add?dry_run=true",
+			body:   `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`,
+			status: http.StatusOK},
+		{name: "create existing team", method: http.MethodPost, target: "/team/add",
+			body:   `{"team_name":"exists","members":[]}`,
+			status: http.StatusBadRequest},
+		{name: "reviewers_required from untrusted caller", method: http.MethodPost, target: "/team/add",
+			body:   `{"team_name":"backend","members":[],"reviewers_required":3}`,
+			status: http.StatusForbidden},
+		{name: "update team", method: http.MethodPost, target: "/team/update", trusted: true,
+			body:   `{"team_name":"backend","reviewers_required":3}`,
+			status: http.StatusOK},
+		{name: "get team", method: http.MethodGet, target: "/team/get?team_name=backend", status: http.StatusOK},
+		{name: "get team page", method: http.MethodGet, target: "/team/get?team_name=backend&members_limit=10", status: http.StatusOK},
+		{name: "get team summary", method: http.MethodGet, target: "/team/get?team_name=backend&summary=true", status: http.StatusOK},
+		{name: "get missing team", method: http.MethodGet, target: "/team/get?team_name=frontend", status: http.StatusNotFound},
+
+		{name: "set user activity", method: http.MethodPost, target: "/users/setIsActive",
+			body:   `{"user_id":"u2","is_active":false}`,
+			status: http.StatusOK},
+		{name: "set activity of missing user", method: http.MethodPost, target: "/users/setIsActive",
+			body:   `{"user_id":"missing","is_active":false}`,
+			status: http.StatusNotFound},
+		{name: "reviewer queue", method: http.MethodGet, target: "/users/getReview?user_id=u2", status: http.StatusOK},
+
+		{name: "create pull request", method: http.MethodPost, target: "/pullRequest/create",
+			body:   `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`,
+			status: http.StatusCreated},
+		{name: "create pull request dry run", method: http.MethodPost, target: "/pullRequest/create?dry_run=true",
+			body:   `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`,
+			status: http.StatusOK},
+		{name: "create pull request with unknown field", method: http.MethodPost, target: "/pullRequest/create",
+			body:   `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","createdAt":"2025-10-01T10:00:00Z"}`,
+			status: http.StatusBadRequest},
+		{name: "create existing pull request", method: http.MethodPost, target: "/pullRequest/create",
+			body:   `{"pull_request_id":"pr-exists","pull_request_name":"Add search","author_id":"u1"}`,
+			status: http.StatusConflict},
+		{name: "merge pull request", method: http.MethodPost, target: "/pullRequest/merge",
+			body:   `{"pull_request_id":"pr-1"}`,
+			status: http.StatusOK},
+		{name: "merge missing pull request", method: http.MethodPost, target: "/pullRequest/merge",
+			body:   `{"pull_request_id":"missing"}`,
+			status: http.StatusNotFound},
+		{name: "reassign reviewer", method: http.MethodPost, target: "/pullRequest/reassign",
+			body:   `{"pull_request_id":"pr-1","old_user_id":"u2"}`,
+			status: http.StatusOK},
+		{name: "reassign unassigned reviewer", method: http.MethodPost, target: "/pullRequest/reassign",
+			body:   `{"pull_request_id":"pr-1","old_user_id":"u9"}`,
+			status: http.StatusConflict},
+		{name: "assignment freezes for untrusted caller", method: http.MethodGet, target: "/admin/assignmentFreezes", status: http.StatusForbidden},
+		{name: "malformed body", method: http.MethodPost, target: "/pullRequest/merge",
+			body:   `{"pull_request_id":`,
+			status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			if tt.trusted {
+				req.Header.Set("Authorization", "Bearer "+contractToken)
+			}
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d; body: %s", rec.Code, tt.status, rec.Body)
+			}
+
+			route, pathParams, err := contract.FindRoute(req)
+			if err != nil {
+				t.Fatalf("operation is not in openapi.yaml: %v", err)
+			}
+			err = openapi3filter.ValidateResponse(context.Background(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request:    req,
+					PathParams: pathParams,
+					Route:      route,
+				},
+				Status:  rec.Code,
+				Header:  rec.Header(),
+				Body:    io.NopCloser(bytes.NewReader(rec.Body.Bytes())),
+				Options: &openapi3filter.Options{IncludeResponseStatus: true},
+			})
+			if err != nil {
+				t.Errorf("response does not match openapi.yaml: %v\nbody: %s", err, rec.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+func (h *handler) handleStatsDeclineReasons(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+	periodName := strings.TrimSpace(r.URL.Query().Get("period"))
+	period, ok := leaderboardPeriods[periodName]
+	if !ok {
+		writeValidationError(w, errors.New("period must be one of week, month, all"))
+		return
+	}
+	if periodName == "" {
+		periodName = "all"
+	}
+
+	stats, err := h.stats.GetDeclineReasonStats(r.Context(), teamName, period)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	reasons := make([]map[string]any, 0, len(stats.Reasons))
+	for _, reason := range stats.Reasons {
+		share := 0.0
+		if stats.Total > 0 {
+			share = float64(reason.Count) / float64(stats.Total)
+		}
+		reasons = append(reasons, map[string]any{
+			"reason": string(reason.Reason),
+			"count":  reason.Count,
+			"share":  share,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team_name":   stats.TeamName,
+		"period":      periodName,
+		"total":       stats.Total,
+		"unspecified": stats.Unspecified,
+		"reasons":     reasons,
+	})
+}
@@ -0,0 +1,21 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+)
+
+func withDryRun(r *http.Request) (*http.Request, bool) {
+	if r.URL.Query().Get("dry_run") != "true" {
+		return r, false
+	}
+	return r.WithContext(ctxutil.WithDryRun(r.Context())), true
+}
+
+func mutationStatus(dryRun bool, status int) int {
+	if dryRun {
+		return http.StatusOK
+	}
+	return status
+}
@@ -0,0 +1,42 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+)
+
+func (h *handler) handleTeamUniquePRNames(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName string `json:"team_name"`
+		Enabled  *bool  `json:"enabled"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.TeamName == "" || req.Enabled == nil {
+		writeValidationError(w, errors.New("team_name and enabled are required"))
+		return
+	}
+
+	team, conflicts, err := h.teams.SetUniqueOpenPRNames(r.Context(), req.TeamName, *req.Enabled)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	items := make([]map[string]any, 0, len(conflicts))
+	for _, c := range conflicts {
+		items = append(items, map[string]any{
+			"author_id":         c.AuthorID,
+			"pull_request_name": c.PullRequestName,
+			"enforced_id":       c.EnforcedID,
+			"duplicate_ids":     c.DuplicateIDs,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team":      mapTeam(team),
+		"conflicts": items,
+	})
+}
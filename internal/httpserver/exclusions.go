@@ -0,0 +1,86 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+type reviewerExclusionRequest struct {
+	UserAID string `json:"user_a_id"`
+	UserBID string `json:"user_b_id"`
+	Reason  string `json:"reason"`
+}
+
+func (h *handler) handleAdminExclusionsList(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "reviewer exclusions are available only for trusted callers")
+		return
+	}
+
+	exclusions, err := h.admin.ListReviewerExclusions(r.Context())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	result := make([]map[string]any, 0, len(exclusions))
+	for _, e := range exclusions {
+		result = append(result, mapReviewerExclusion(e))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"exclusions": result,
+	})
+}
+
+func (h *handler) handleAdminExclusionsAdd(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "managing reviewer exclusions is allowed only for trusted callers")
+		return
+	}
+
+	var req reviewerExclusionRequest
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	exclusion, err := h.admin.AddReviewerExclusion(r.Context(), req.UserAID, req.UserBID, req.Reason)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"exclusion": mapReviewerExclusion(exclusion),
+	})
+}
+
+func (h *handler) handleAdminExclusionsRemove(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "managing reviewer exclusions is allowed only for trusted callers")
+		return
+	}
+
+	var req reviewerExclusionRequest
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	if err := h.admin.RemoveReviewerExclusion(r.Context(), req.UserAID, req.UserBID); err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	h.handleAdminExclusionsList(w, r)
+}
+
+func mapReviewerExclusion(e domain.ReviewerExclusion) map[string]any {
+	return map[string]any{
+		"user_a_id":  e.UserA,
+		"user_b_id":  e.UserB,
+		"reason":     e.Reason,
+		"created_at": formatTime(e.CreatedAt),
+	}
+}
@@ -0,0 +1,33 @@
+package httpserver
+
+import (
+	"net/http"
+	"time"
+)
+
+func (h *handler) handleAdminExports(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "history exports are available only for trusted callers")
+		return
+	}
+
+	exports, err := h.admin.ListHistoryExports(r.Context())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	result := make([]map[string]any, 0, len(exports))
+	for _, e := range exports {
+		result = append(result, map[string]any{
+			"kind":        e.Kind,
+			"date":        e.Date.Format(time.DateOnly),
+			"object_key":  e.ObjectKey,
+			"rows":        e.Rows,
+			"bytes":       e.Bytes,
+			"exported_at": formatTime(e.ExportedAt),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"exports": result})
+}
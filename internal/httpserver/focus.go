@@ -0,0 +1,86 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleUserFocusWindowsGet(w http.ResponseWriter, r *http.Request) {
+	userID := strings.TrimSpace(r.URL.Query().Get("user_id"))
+	if userID == "" {
+		writeValidationError(w, errors.New("user_id query parameter is required"))
+		return
+	}
+
+	schedule, err := h.users.GetFocusSchedule(r.Context(), userID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapFocusSchedule(schedule))
+}
+
+func (h *handler) handleUserFocusWindowsSet(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID   string `json:"user_id"`
+		Timezone string `json:"timezone"`
+		Windows  []struct {
+			Start string `json:"start"`
+			End   string `json:"end"`
+		} `json:"windows"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.UserID == "" {
+		writeValidationError(w, errors.New("user_id is required"))
+		return
+	}
+
+	windows := make([]domain.FocusWindow, 0, len(req.Windows))
+	for _, win := range req.Windows {
+		start, err := domain.ParseClock(win.Start)
+		if err != nil {
+			writeValidationError(w, err)
+			return
+		}
+		end, err := domain.ParseClock(win.End)
+		if err != nil {
+			writeValidationError(w, err)
+			return
+		}
+		windows = append(windows, domain.FocusWindow{Start: start, End: end})
+	}
+
+	schedule, err := h.users.SetFocusSchedule(r.Context(), req.UserID, req.Timezone, windows)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapFocusSchedule(schedule))
+}
+
+func mapFocusSchedule(f domain.FocusSchedule) map[string]any {
+	windows := make([]map[string]any, 0, len(f.Windows))
+	for _, win := range f.Windows {
+		windows = append(windows, map[string]any{
+			"start": domain.FormatClock(win.Start),
+			"end":   domain.FormatClock(win.End),
+		})
+	}
+
+	resp := map[string]any{
+		"user_id": f.UserID,
+		"windows": windows,
+	}
+	if f.Timezone != "" {
+		resp["timezone"] = f.Timezone
+	}
+	return resp
+}
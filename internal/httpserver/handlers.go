@@ -1,89 +1,236 @@
 package httpserver
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/health"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpclient"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpserver/pagination"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/replica"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/service"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/storage/postgres"
+	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 )
 
 type handler struct {
-	svc    Service
-	logger *zap.Logger
+	teams        TeamService
+	users        UserService
+	pullRequests PullRequestService
+	stats        StatsService
+	admin        AdminService
+	logger       *zap.Logger
+	trustedToken func() string
+	shedder      *loadShedder
+	replica      *replica.State
+	health       *health.Registry
+	outbound     *httpclient.Registry
+	queries      *postgres.QueryHistogram
+
+	readConsistency string
+
+	poolExhausted atomic.Int64
+	panics        atomic.Int64
+	statements    statementStats
 }
 
 func (h *handler) handleHealth(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	writeJSON(w, http.StatusOK, map[string]string{
-		"status":    "ok",
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
+	writeJSON(w, http.StatusOK, map[string]any{
+		"status":         "ok",
+		"timestamp":      time.Now().UTC().Format(time.RFC3339),
+		"in_flight":      h.shedder.inFlight.Load(),
+		"shed_requests":  h.shedder.shed.Load(),
+		"pool_exhausted": h.poolExhausted.Load(),
+		"panics":         h.panics.Load(),
 	})
 }
 
 func (h *handler) handleTeamAdd(w http.ResponseWriter, r *http.Request) {
+	r, dryRun := withDryRun(r)
+
 	var req struct {
 		TeamName string `json:"team_name"`
 		Members  []struct {
-			UserID   string `json:"user_id"`
-			Username string `json:"username"`
-			IsActive bool   `json:"is_active"`
+			UserID    string `json:"user_id"`
+			Username  string `json:"username"`
+			IsActive  bool   `json:"is_active"`
+			Seniority string `json:"seniority"`
 		} `json:"members"`
+		ReviewersRequired *int `json:"reviewers_required"`
 	}
 
 	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
 		writeValidationError(w, err)
 		return
 	}
-	if req.TeamName == "" {
-		writeValidationError(w, errors.New("team_name is required"))
+	if req.ReviewersRequired != nil && !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "reviewers_required can be set only by trusted callers")
+		return
+	}
+
+	members := make([]domain.TeamMember, 0, len(req.Members))
+	for _, m := range req.Members {
+		members = append(members, domain.TeamMember{
+			UserID:    m.UserID,
+			Username:  m.Username,
+			IsActive:  m.IsActive,
+			Seniority: domain.Seniority(m.Seniority),
+		})
+	}
+
+	team, err := h.teams.CreateTeam(r.Context(), req.TeamName, members, req.ReviewersRequired)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	resp := map[string]any{
+		"team": mapTeam(team),
+	}
+	if req.ReviewersRequired != nil {
+		resp["reviewers_required"] = *req.ReviewersRequired
+	}
+	if dryRun {
+		resp["dry_run"] = true
+	}
+	writeJSON(w, mutationStatus(dryRun, http.StatusCreated), resp)
+}
+
+func (h *handler) handleTeamUpdate(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "team settings can be changed only by trusted callers")
+		return
+	}
+
+	var req struct {
+		TeamName          string `json:"team_name"`
+		ReviewersRequired *int   `json:"reviewers_required"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	team, reviewers, err := h.teams.UpdateTeam(r.Context(), req.TeamName, req.ReviewersRequired)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team_name":          team.Name,
+		"reviewers_required": reviewers,
+	})
+}
+
+func (h *handler) handleTeamPut(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName string `json:"team_name"`
+		Members  []struct {
+			UserID    string `json:"user_id"`
+			Username  string `json:"username"`
+			IsActive  bool   `json:"is_active"`
+			Seniority string `json:"seniority"`
+		} `json:"members"`
+	}
+
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
 		return
 	}
 
 	members := make([]domain.TeamMember, 0, len(req.Members))
 	for _, m := range req.Members {
-		if m.UserID == "" || m.Username == "" {
-			writeValidationError(w, errors.New("members.user_id and members.username are required"))
-			return
-		}
 		members = append(members, domain.TeamMember{
-			UserID:   m.UserID,
-			Username: m.Username,
-			IsActive: m.IsActive,
+			UserID:    m.UserID,
+			Username:  m.Username,
+			IsActive:  m.IsActive,
+			Seniority: domain.Seniority(m.Seniority),
 		})
 	}
 
-	team, err := h.svc.CreateTeam(r.Context(), req.TeamName, members)
+	team, plan, err := h.teams.UpsertTeam(r.Context(), req.TeamName, members)
 	if err != nil {
 		h.writeServiceError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, map[string]any{
+	writeJSON(w, http.StatusOK, map[string]any{
 		"team": mapTeam(team),
+		"diff": mapTeamPlan(plan),
 	})
 }
 
-func (h *handler) handleTeamGet(w http.ResponseWriter, r *http.Request) {
-	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
-	if teamName == "" {
-		writeValidationError(w, errors.New("team_name query parameter is required"))
+func (h *handler) handleTeamApply(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Teams []struct {
+			TeamName string `json:"team_name"`
+			Members  []struct {
+				UserID    string `json:"user_id"`
+				Username  string `json:"username"`
+				IsActive  bool   `json:"is_active"`
+				Seniority string `json:"seniority"`
+			} `json:"members"`
+		} `json:"teams"`
+	}
+
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
 		return
 	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	seenTeams := make(map[string]bool, len(req.Teams))
+	seenUsers := make(map[string]string)
+	teams := make([]domain.Team, 0, len(req.Teams))
+	for _, t := range req.Teams {
+		teamKey := domain.TeamNameKey(t.TeamName)
+		if seenTeams[teamKey] {
+			writeValidationError(w, fmt.Errorf("team %q is declared more than once", t.TeamName))
+			return
+		}
+		seenTeams[teamKey] = true
+
+		members := make([]domain.TeamMember, 0, len(t.Members))
+		for _, m := range t.Members {
+			userKey := domain.NormalizeID(m.UserID)
+			if other, ok := seenUsers[userKey]; ok {
+				writeValidationError(w, fmt.Errorf("user %q is declared in teams %q and %q", m.UserID, other, t.TeamName))
+				return
+			}
+			seenUsers[userKey] = t.TeamName
+			members = append(members, domain.TeamMember{
+				UserID:    m.UserID,
+				Username:  m.Username,
+				IsActive:  m.IsActive,
+				Seniority: domain.Seniority(m.Seniority),
+			})
+		}
+		teams = append(teams, domain.Team{Name: t.TeamName, Members: members})
+	}
 
-	team, err := h.svc.GetTeam(r.Context(), teamName)
+	plans, err := h.teams.ApplyTeams(r.Context(), teams, dryRun)
 	if err != nil {
 		h.writeServiceError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, mapTeam(team))
+	writeJSON(w, http.StatusOK, map[string]any{
+		"dry_run": dryRun,
+		"plan":    mapTeamPlans(plans),
+	})
 }
 
 func (h *handler) handleUserSetActive(w http.ResponseWriter, r *http.Request) {
@@ -100,7 +247,7 @@ func (h *handler) handleUserSetActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := h.svc.SetUserActivity(r.Context(), req.UserID, req.IsActive)
+	user, err := h.users.SetUserActivity(r.Context(), req.UserID, req.IsActive)
 	if err != nil {
 		h.writeServiceError(w, err)
 		return
@@ -112,34 +259,38 @@ func (h *handler) handleUserSetActive(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *handler) handlePullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	r, dryRun := withDryRun(r)
+
 	var req struct {
-		ID       string `json:"pull_request_id"`
-		Name     string `json:"pull_request_name"`
-		AuthorID string `json:"author_id"`
+		ID           string `json:"pull_request_id"`
+		Name         string `json:"pull_request_name"`
+		AuthorID     string `json:"author_id"`
+		LinesChanged *int   `json:"lines_changed"`
 	}
 	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
 		writeValidationError(w, err)
 		return
 	}
-	if req.ID == "" || req.Name == "" || req.AuthorID == "" {
-		writeValidationError(w, errors.New("pull_request_id, pull_request_name and author_id are required"))
-		return
-	}
-
-	pr, err := h.svc.CreatePullRequest(r.Context(), req.ID, req.Name, req.AuthorID)
+	pr, err := h.pullRequests.CreatePullRequest(r.Context(), req.ID, req.Name, req.AuthorID, req.LinesChanged)
 	if err != nil {
 		h.writeServiceError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, map[string]any{
+	resp := map[string]any{
 		"pr": mapPullRequest(pr),
-	})
+	}
+	if dryRun {
+		resp["dry_run"] = true
+	}
+	writeJSON(w, mutationStatus(dryRun, http.StatusCreated), resp)
 }
 
 func (h *handler) handlePullRequestMerge(w http.ResponseWriter, r *http.Request) {
 	var req struct {
-		ID string `json:"pull_request_id"`
+		ID       string     `json:"pull_request_id"`
+		MergedBy string     `json:"merged_by"`
+		MergedAt *time.Time `json:"merged_at"`
 	}
 	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
 		writeValidationError(w, err)
@@ -149,8 +300,15 @@ func (h *handler) handlePullRequestMerge(w http.ResponseWriter, r *http.Request)
 		writeValidationError(w, errors.New("pull_request_id is required"))
 		return
 	}
+	if (req.MergedBy != "" || req.MergedAt != nil) && !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "merged_by and merged_at are accepted only from trusted callers")
+		return
+	}
 
-	pr, err := h.svc.MergePullRequest(r.Context(), req.ID)
+	pr, err := h.pullRequests.MergePullRequest(r.Context(), req.ID, domain.MergeActor{
+		MergedBy: req.MergedBy,
+		MergedAt: req.MergedAt,
+	})
 	if err != nil {
 		h.writeServiceError(w, err)
 		return
@@ -162,10 +320,14 @@ func (h *handler) handlePullRequestMerge(w http.ResponseWriter, r *http.Request)
 }
 
 func (h *handler) handlePullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	r, dryRun := withDryRun(r)
+
 	var req struct {
 		ID            string `json:"pull_request_id"`
 		OldUserID     string `json:"old_user_id"`
 		OldReviewerID string `json:"old_reviewer_id"`
+		Reason        string `json:"reason"`
+		Note          string `json:"note"`
 	}
 	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
 		writeValidationError(w, err)
@@ -180,16 +342,20 @@ func (h *handler) handlePullRequestReassign(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	pr, replacedBy, err := h.svc.ReassignReviewer(r.Context(), req.ID, oldReviewer)
+	pr, replacedBy, err := h.pullRequests.ReassignReviewer(r.Context(), req.ID, oldReviewer, domain.DeclineReason(req.Reason), req.Note)
 	if err != nil {
 		h.writeServiceError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, map[string]any{
+	resp := map[string]any{
 		"pr":          mapPullRequest(pr),
 		"replaced_by": replacedBy,
-	})
+	}
+	if dryRun {
+		resp["dry_run"] = true
+	}
+	writeJSON(w, http.StatusOK, resp)
 }
 
 func (h *handler) handleUserGetReview(w http.ResponseWriter, r *http.Request) {
@@ -199,20 +365,52 @@ func (h *handler) handleUserGetReview(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	prs, err := h.svc.ListReviewerPullRequests(r.Context(), userID)
+	params, err := pagination.Parse(r.URL.Query(), pullRequestListOptions("-created_at"))
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	includeDeleted := r.URL.Query().Get("include_deleted") == "true"
+	if includeDeleted && !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "include_deleted is accepted only from trusted callers")
+		return
+	}
+
+	prs, err := h.users.ListReviewerPullRequests(r.Context(), userID, params.Page(), includeDeleted)
 	if err != nil {
 		h.writeServiceError(w, err)
 		return
 	}
+	prs, next := pagination.Trim(params, prs)
 
-	writeJSON(w, http.StatusOK, map[string]any{
+	resp := map[string]any{
 		"user_id":       userID,
 		"pull_requests": mapPullRequestShortList(prs),
-	})
+	}
+	if next != "" {
+		resp["next_cursor"] = next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func pullRequestListOptions(defaultSort string) pagination.Options {
+	return pagination.Options{
+		MaxLimit:    500,
+		SortFields:  []string{"created_at", "name", "id"},
+		DefaultSort: defaultSort,
+	}
 }
 
 func (h *handler) writeServiceError(w http.ResponseWriter, err error) {
 	status, code := mapServiceError(err)
+	if code == "POOL_EXHAUSTED" {
+		h.poolExhausted.Add(1)
+	}
+	var rateLimited *service.AuthorRateLimitError
+	if errors.As(err, &rateLimited) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(time.Until(rateLimited.ResetAt).Round(time.Second)/time.Second)))
+	}
 	if status >= http.StatusInternalServerError {
 		h.logger.Error("service error", zap.Error(err))
 	}
@@ -220,7 +418,10 @@ func (h *handler) writeServiceError(w http.ResponseWriter, err error) {
 }
 
 func mapServiceError(err error) (int, string) {
+	var validationErr *domain.ValidationError
 	switch {
+	case errors.As(err, &validationErr):
+		return http.StatusBadRequest, "NOT_FOUND"
 	case errors.Is(err, service.ErrTeamExists):
 		return http.StatusBadRequest, "TEAM_EXISTS"
 	case errors.Is(err, service.ErrTeamNotFound),
@@ -228,31 +429,122 @@ func mapServiceError(err error) (int, string) {
 		return http.StatusNotFound, "NOT_FOUND"
 	case errors.Is(err, service.ErrPullRequestExists):
 		return http.StatusConflict, "PR_EXISTS"
+	case errors.Is(err, service.ErrUserExists):
+		return http.StatusConflict, "USER_EXISTS"
 	case errors.Is(err, service.ErrPullRequestNotFound):
 		return http.StatusNotFound, "NOT_FOUND"
 	case errors.Is(err, service.ErrPullRequestMerged):
 		return http.StatusConflict, "PR_MERGED"
 	case errors.Is(err, service.ErrReviewerNotAssigned):
 		return http.StatusConflict, "NOT_ASSIGNED"
+	case errors.Is(err, service.ErrReviewerAlreadyAssigned):
+		return http.StatusConflict, "ALREADY_ASSIGNED"
 	case errors.Is(err, service.ErrNoCandidate):
 		return http.StatusConflict, "NO_CANDIDATE"
+	case errors.Is(err, service.ErrChecklistItemNotFound),
+		errors.Is(err, service.ErrRotationNotFound),
+		errors.Is(err, service.ErrExclusionNotFound):
+		return http.StatusNotFound, "NOT_FOUND"
+	case errors.Is(err, service.ErrChecklistIncomplete):
+		return http.StatusConflict, "CHECKLIST_INCOMPLETE"
+	case errors.Is(err, service.ErrInvalidSnooze):
+		return http.StatusBadRequest, "NOT_FOUND"
+	case errors.Is(err, service.ErrInvalidCalendar):
+		return http.StatusBadRequest, "NOT_FOUND"
+	case errors.Is(err, service.ErrSnoozeBudgetExceeded):
+		return http.StatusConflict, "SNOOZE_BUDGET_EXCEEDED"
+	case errors.Is(err, service.ErrInvalidQuorum):
+		return http.StatusBadRequest, "NOT_FOUND"
+	case errors.Is(err, service.ErrQuorumUnsatisfied):
+		return http.StatusConflict, "QUORUM_UNSATISFIED"
+	case errors.Is(err, service.ErrQuorumNotMet):
+		return http.StatusConflict, "QUORUM_NOT_MET"
+	case errors.Is(err, service.ErrApprovalsNotMet):
+		return http.StatusConflict, "APPROVALS_NOT_MET"
+	case errors.Is(err, service.ErrDependencyCycle):
+		return http.StatusConflict, "DEPENDENCY_CYCLE"
+	case errors.Is(err, service.ErrInvalidPullRequestID):
+		return http.StatusBadRequest, "NOT_FOUND"
+	case errors.Is(err, service.ErrDuplicatePullRequest):
+		return http.StatusConflict, "DUPLICATE_PR"
+	case errors.Is(err, service.ErrInvalidMergeTime):
+		return http.StatusBadRequest, "NOT_FOUND"
+	case errors.Is(err, service.ErrNotPullRequestAuthor):
+		return http.StatusForbidden, "FORBIDDEN"
+	case errors.Is(err, service.ErrAuthorRateLimited):
+		return http.StatusTooManyRequests, "AUTHOR_RATE_LIMITED"
+	case errors.Is(err, service.ErrSyncCursorExpired):
+		return http.StatusGone, "SYNC_CURSOR_EXPIRED"
+	case errors.Is(err, service.ErrUserVersionConflict):
+		return http.StatusConflict, "VERSION_CONFLICT"
+	case errors.Is(err, service.ErrNoPendingReviews),
+		errors.Is(err, service.ErrReviewSessionNotFound),
+		errors.Is(err, service.ErrFreezeNotFound):
+		return http.StatusNotFound, "NOT_FOUND"
+	case errors.Is(err, service.ErrReviewSessionCompleted):
+		return http.StatusConflict, "SESSION_COMPLETED"
+	case errors.Is(err, service.ErrPoolExhausted):
+		return http.StatusServiceUnavailable, "POOL_EXHAUSTED"
 	default:
 		return http.StatusInternalServerError, "NOT_FOUND"
 	}
 }
 
 func mapTeam(team domain.Team) map[string]any {
-	members := make([]map[string]any, 0, len(team.Members))
-	for _, m := range team.Members {
-		members = append(members, map[string]any{
-			"user_id":   m.UserID,
-			"username":  m.Username,
-			"is_active": m.IsActive,
-		})
+	resp := mapTeamSettings(team)
+	resp["members"] = mapTeamMembers(team.Members)
+	return resp
+}
+
+func mapTeamMembers(members []domain.TeamMember) []map[string]any {
+	result := make([]map[string]any, 0, len(members))
+	for _, m := range members {
+		result = append(result, mapTeamMember(m))
+	}
+	return result
+}
+
+func mapTeamMember(m domain.TeamMember) map[string]any {
+	member := map[string]any{
+		"user_id":   m.UserID,
+		"username":  m.Username,
+		"is_active": m.IsActive,
+		"seniority": string(m.Seniority),
+	}
+	if m.RampUpUntil != nil {
+		member["rampUpUntil"] = formatTime(*m.RampUpUntil)
 	}
+	return member
+}
+
+func mapTeamSettings(team domain.Team) map[string]any {
 	return map[string]any{
-		"team_name": team.Name,
-		"members":   members,
+		"team_name":            team.Name,
+		"checklist":            mapChecklist(team.Checklist),
+		"checklist_required":   team.ChecklistRequired,
+		"quorum":               mapQuorumRules(team.Quorum),
+		"unique_open_pr_names": team.UniqueOpenPRNames,
+		"ramp_up_days":         team.RampUpDays,
+		"mentoring_shadows":    team.MentoringShadows,
+		"assignment_strategy":  string(team.AssignmentStrategy),
+	}
+}
+
+func mapTeamPlans(plans []domain.TeamPlan) []map[string]any {
+	result := make([]map[string]any, 0, len(plans))
+	for _, p := range plans {
+		result = append(result, mapTeamPlan(p))
+	}
+	return result
+}
+
+func mapTeamPlan(p domain.TeamPlan) map[string]any {
+	return map[string]any{
+		"team_name":       p.TeamName,
+		"action":          string(p.Action),
+		"added_members":   p.AddedMembers,
+		"updated_members": p.UpdatedMembers,
+		"removed_members": p.RemovedMembers,
 	}
 }
 
@@ -266,16 +558,23 @@ func mapUser(u domain.User) map[string]any {
 		"username":  u.Username,
 		"team_name": teamName,
 		"is_active": u.IsActive,
+		"seniority": string(u.Seniority),
+		"version":   u.Version,
 	}
 }
 
 func mapPullRequest(pr domain.PullRequest) map[string]any {
 	resp := map[string]any{
-		"pull_request_id":    pr.ID,
-		"pull_request_name":  pr.Name,
-		"author_id":          pr.AuthorID,
-		"status":             string(pr.Status),
-		"assigned_reviewers": pr.Reviewers,
+		"pull_request_id":      pr.ID,
+		"pull_request_name":    pr.Name,
+		"author_id":            pr.AuthorID,
+		"status":               string(pr.Status),
+		"assigned_reviewers":   pr.Reviewers,
+		"shadow_reviewers":     pr.Shadows,
+		"checklist":            mapChecklistState(pr.Checklist),
+		"reviewer_assignments": mapReviewerAssignments(pr.Assignments),
+		"blocked_by":           mapPullRequestShortList(pr.BlockedBy),
+		"approvals":            pr.Approvals(),
 	}
 	if !pr.CreatedAt.IsZero() {
 		resp["createdAt"] = formatTime(pr.CreatedAt)
@@ -283,18 +582,78 @@ func mapPullRequest(pr domain.PullRequest) map[string]any {
 	if pr.MergedAt != nil {
 		resp["mergedAt"] = formatTime(*pr.MergedAt)
 	}
+	if pr.MergedBy != nil {
+		resp["mergedBy"] = *pr.MergedBy
+	}
+	if pr.ReviewDueAt != nil {
+		resp["reviewDueAt"] = formatTime(*pr.ReviewDueAt)
+	}
+	if pr.DeletedAt != nil {
+		resp["deletedAt"] = formatTime(*pr.DeletedAt)
+	}
+	if pr.DeletedBy != nil {
+		resp["deletedBy"] = *pr.DeletedBy
+	}
+	if pr.ApprovalsResetAt != nil {
+		resp["approvalsResetAt"] = formatTime(*pr.ApprovalsResetAt)
+	}
+	if pr.LinesChanged != nil {
+		resp["lines_changed"] = *pr.LinesChanged
+	}
+	if pr.AssignmentDeferredAt != nil {
+		resp["assignmentDeferredAt"] = formatTime(*pr.AssignmentDeferredAt)
+	}
 	return resp
 }
 
+func mapReviewerAssignments(assignments []domain.ReviewerAssignment) []map[string]any {
+	result := make([]map[string]any, 0, len(assignments))
+	for _, a := range assignments {
+		resp := map[string]any{
+			"reviewer_id": a.ReviewerID,
+			"kind":        string(a.Kind),
+			"assignedAt":  formatTime(a.AssignedAt),
+		}
+		if a.FirstResponseAt != nil {
+			resp["firstResponseAt"] = formatTime(*a.FirstResponseAt)
+		}
+		if a.CompletedAt != nil {
+			resp["completedAt"] = formatTime(*a.CompletedAt)
+		}
+		if a.ApprovedAt != nil {
+			resp["approvedAt"] = formatTime(*a.ApprovedAt)
+		}
+		if a.SnoozedUntil != nil {
+			resp["snoozedUntil"] = formatTime(*a.SnoozedUntil)
+		}
+		if a.Handoff != nil {
+			handoff := map[string]any{"from_reviewer_id": a.Handoff.FromReviewerID}
+			if a.Handoff.Note != "" {
+				handoff["note"] = a.Handoff.Note
+			}
+			resp["handoff"] = handoff
+		}
+		result = append(result, resp)
+	}
+	return result
+}
+
 func mapPullRequestShortList(prs []domain.PullRequestShort) []map[string]any {
 	result := make([]map[string]any, 0, len(prs))
 	for _, pr := range prs {
-		result = append(result, map[string]any{
+		resp := map[string]any{
 			"pull_request_id":   pr.ID,
 			"pull_request_name": pr.Name,
 			"author_id":         pr.AuthorID,
 			"status":            string(pr.Status),
-		})
+		}
+		if pr.DeletedAt != nil {
+			resp["deletedAt"] = formatTime(*pr.DeletedAt)
+		}
+		if pr.ApprovalsResetAt != nil {
+			resp["approvalsResetAt"] = formatTime(*pr.ApprovalsResetAt)
+		}
+		result = append(result, resp)
 	}
 	return result
 }
@@ -303,9 +662,29 @@ func formatTime(t time.Time) string {
 	return t.UTC().Format(time.RFC3339)
 }
 
+const (
+	maxRequestBodyBytes = 4 << 20
+	maxJSONDepth        = 32
+)
+
 func decodeJSON(ctx context.Context, body io.ReadCloser, dst any) error {
+	return decodeJSONLimit(ctx, body, dst, maxRequestBodyBytes)
+}
+
+func decodeJSONLimit(ctx context.Context, body io.ReadCloser, dst any, limit int64) error {
 	defer body.Close()
-	dec := json.NewDecoder(body)
+	data, err := io.ReadAll(io.LimitReader(body, limit+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) > limit {
+		return fmt.Errorf("request body exceeds %d bytes", limit)
+	}
+	if err := checkJSONDepth(data); err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
 	dec.DisallowUnknownFields()
 
 	if err := dec.Decode(dst); err != nil {
@@ -320,19 +699,57 @@ func decodeJSON(ctx context.Context, body io.ReadCloser, dst any) error {
 	return nil
 }
 
+func checkJSONDepth(data []byte) error {
+	depth := 0
+	inString, escaped := false, false
+	for _, c := range data {
+		switch {
+		case escaped:
+			escaped = false
+		case inString:
+			switch c {
+			case '\\':
+				escaped = true
+			case '"':
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+			if depth > maxJSONDepth {
+				return fmt.Errorf("JSON nesting exceeds %d levels", maxJSONDepth)
+			}
+		case c == '}' || c == ']':
+			depth--
+		}
+	}
+	return nil
+}
+
 func writeJSON(w http.ResponseWriter, status int, payload any) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(payload)
+	version := responseVersion(w)
+	writeBody(w, status, version, responseEncoders[version](payload))
 }
 
 func writeError(w http.ResponseWriter, status int, code, message string) {
-	writeJSON(w, status, map[string]any{
-		"error": map[string]any{
-			"code":    code,
-			"message": message,
-		},
-	})
+	body := map[string]any{
+		"code":    code,
+		"message": message,
+	}
+	if requestID := w.Header().Get(middleware.RequestIDHeader); requestID != "" {
+		body["request_id"] = requestID
+	}
+	if traceID := w.Header().Get(traceIDHeader); traceID != "" {
+		body["trace_id"] = traceID
+	}
+	writeBody(w, status, responseVersion(w), map[string]any{"error": body})
+}
+
+func writeBody(w http.ResponseWriter, status int, version apiVersion, body any) {
+	w.Header().Set("Content-Type", version.contentType())
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
 }
 
 func writeValidationError(w http.ResponseWriter, err error) {
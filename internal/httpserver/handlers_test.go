@@ -0,0 +1,53 @@
+package httpserver
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func FuzzDecodeJSON(f *testing.F) {
+	f.Add(`{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`)
+	f.Add(`{"team_name":"backend"} {}`)
+	f.Add(`{"team_name":"backend","unknown":1}`)
+	f.Add(`{"members":[{"is_active":1e400}]}`)
+	f.Add(strings.Repeat("[", maxJSONDepth+1))
+	f.Add(`{"team_name":"\"]]]]"}`)
+	f.Add("")
+	f.Fuzz(func(t *testing.T, body string) {
+		var req struct {
+			TeamName string `json:"team_name"`
+			Members  []struct {
+				UserID    string `json:"user_id"`
+				Username  string `json:"username"`
+				IsActive  bool   `json:"is_active"`
+				Seniority string `json:"seniority"`
+			} `json:"members"`
+		}
+		err := decodeJSON(context.Background(), io.NopCloser(strings.NewReader(body)), &req)
+		if err == nil && checkJSONDepth([]byte(body)) != nil {
+			t.Errorf("decodeJSON accepted input nested deeper than %d levels", maxJSONDepth)
+		}
+	})
+}
+
+func TestDecodeJSONLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "too deep", body: strings.Repeat(`{"a":`, maxJSONDepth+1) + "1" + strings.Repeat("}", maxJSONDepth+1)},
+		{name: "too large", body: `"` + strings.Repeat("a", maxRequestBodyBytes) + `"`},
+		{name: "trailing data", body: `{} {}`},
+		{name: "unknown field", body: `{"unexpected":true}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst struct{}
+			if err := decodeJSON(context.Background(), io.NopCloser(strings.NewReader(tt.body)), &dst); err == nil {
+				t.Errorf("decodeJSON accepted %s input", tt.name)
+			}
+		})
+	}
+}
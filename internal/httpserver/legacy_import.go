@@ -0,0 +1,253 @@
+package httpserver
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+const maxLegacyImportBytes = 64 << 20
+
+var legacyCSVColumns = []string{
+	"kind", "team_name", "user_id", "username", "is_active", "seniority",
+	"pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at",
+	"reviewer_id", "assigned_at",
+}
+
+func (h *handler) handleAdminImportLegacy(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "legacy import is allowed only for trusted callers")
+		return
+	}
+
+	var (
+		data domain.LegacyImport
+		err  error
+	)
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "text/csv" {
+		data, err = decodeLegacyCSV(http.MaxBytesReader(w, r.Body, maxLegacyImportBytes))
+	} else {
+		data, err = decodeLegacyJSON(r)
+	}
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	report, err := h.admin.ImportLegacy(r.Context(), data, dryRun)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"dry_run":       report.DryRun,
+		"applied":       report.Applied,
+		"teams":         report.Teams,
+		"users":         report.Users,
+		"pull_requests": report.PullRequests,
+		"reviewers":     report.Reviewers,
+		"issues":        mapLegacyImportIssues(report.Issues),
+		"conflicts":     mapLegacyImportIssues(report.Conflicts),
+	})
+}
+
+func mapLegacyImportIssues(issues []domain.LegacyImportIssue) []map[string]any {
+	resp := make([]map[string]any, 0, len(issues))
+	for _, issue := range issues {
+		resp = append(resp, map[string]any{
+			"item":    issue.Item,
+			"message": issue.Message,
+		})
+	}
+	return resp
+}
+
+func decodeLegacyJSON(r *http.Request) (domain.LegacyImport, error) {
+	var req struct {
+		Teams []struct {
+			TeamName string `json:"team_name"`
+			Members  []struct {
+				UserID    string `json:"user_id"`
+				Username  string `json:"username"`
+				IsActive  bool   `json:"is_active"`
+				Seniority string `json:"seniority"`
+			} `json:"members"`
+		} `json:"teams"`
+		PullRequests []struct {
+			ID        string     `json:"pull_request_id"`
+			Name      string     `json:"pull_request_name"`
+			AuthorID  string     `json:"author_id"`
+			Status    string     `json:"status"`
+			CreatedAt time.Time  `json:"created_at"`
+			MergedAt  *time.Time `json:"merged_at"`
+			Reviewers []struct {
+				UserID     string    `json:"user_id"`
+				AssignedAt time.Time `json:"assigned_at"`
+			} `json:"assigned_reviewers"`
+		} `json:"pull_requests"`
+	}
+	if err := decodeJSONLimit(r.Context(), r.Body, &req, maxLegacyImportBytes); err != nil {
+		return domain.LegacyImport{}, err
+	}
+
+	data := domain.LegacyImport{
+		Teams:        make([]domain.Team, 0, len(req.Teams)),
+		PullRequests: make([]domain.PullRequest, 0, len(req.PullRequests)),
+	}
+	for _, t := range req.Teams {
+		members := make([]domain.TeamMember, 0, len(t.Members))
+		for _, m := range t.Members {
+			members = append(members, domain.TeamMember{
+				UserID:    m.UserID,
+				Username:  m.Username,
+				IsActive:  m.IsActive,
+				Seniority: domain.Seniority(m.Seniority),
+			})
+		}
+		data.Teams = append(data.Teams, domain.Team{Name: t.TeamName, Members: members})
+	}
+	for _, p := range req.PullRequests {
+		assignments := make([]domain.ReviewerAssignment, 0, len(p.Reviewers))
+		for _, rv := range p.Reviewers {
+			assignments = append(assignments, domain.ReviewerAssignment{ReviewerID: rv.UserID, AssignedAt: rv.AssignedAt})
+		}
+		data.PullRequests = append(data.PullRequests, domain.PullRequest{
+			ID:          p.ID,
+			Name:        p.Name,
+			AuthorID:    p.AuthorID,
+			Status:      domain.PullRequestStatus(p.Status),
+			CreatedAt:   p.CreatedAt,
+			MergedAt:    p.MergedAt,
+			Assignments: assignments,
+		})
+	}
+	return data, nil
+}
+
+func decodeLegacyCSV(body io.ReadCloser) (domain.LegacyImport, error) {
+	defer body.Close()
+
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return domain.LegacyImport{}, fmt.Errorf("read CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	if _, ok := columns["kind"]; !ok {
+		return domain.LegacyImport{}, fmt.Errorf("CSV header must contain a kind column and any of: %s", strings.Join(legacyCSVColumns[1:], ", "))
+	}
+
+	var data domain.LegacyImport
+	teams := make(map[string]int)
+	prs := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return domain.LegacyImport{}, err
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		timeField := func(name string) (time.Time, error) {
+			value := field(name)
+			if value == "" {
+				return time.Time{}, nil
+			}
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("line %d: %s must be an RFC 3339 time", line, name)
+			}
+			return t, nil
+		}
+
+		switch kind := strings.ToLower(field("kind")); kind {
+		case "member":
+			isActive := true
+			if value := field("is_active"); value != "" {
+				if isActive, err = strconv.ParseBool(value); err != nil {
+					return domain.LegacyImport{}, fmt.Errorf("line %d: is_active must be true or false", line)
+				}
+			}
+			name := field("team_name")
+			i, ok := teams[name]
+			if !ok {
+				i = len(data.Teams)
+				teams[name] = i
+				data.Teams = append(data.Teams, domain.Team{Name: name})
+			}
+			data.Teams[i].Members = append(data.Teams[i].Members, domain.TeamMember{
+				UserID:    field("user_id"),
+				Username:  field("username"),
+				IsActive:  isActive,
+				Seniority: domain.Seniority(field("seniority")),
+			})
+
+		case "pull_request":
+			id := field("pull_request_id")
+			i, ok := prs[id]
+			if !ok {
+				createdAt, err := timeField("created_at")
+				if err != nil {
+					return domain.LegacyImport{}, err
+				}
+				mergedAt, err := timeField("merged_at")
+				if err != nil {
+					return domain.LegacyImport{}, err
+				}
+				pr := domain.PullRequest{
+					ID:        id,
+					Name:      field("pull_request_name"),
+					AuthorID:  field("author_id"),
+					Status:    domain.PullRequestStatus(strings.ToUpper(field("status"))),
+					CreatedAt: createdAt,
+				}
+				if !mergedAt.IsZero() {
+					pr.MergedAt = &mergedAt
+				}
+				i = len(data.PullRequests)
+				prs[id] = i
+				data.PullRequests = append(data.PullRequests, pr)
+			}
+			if reviewerID := field("reviewer_id"); reviewerID != "" {
+				assignedAt, err := timeField("assigned_at")
+				if err != nil {
+					return domain.LegacyImport{}, err
+				}
+				data.PullRequests[i].Assignments = append(data.PullRequests[i].Assignments, domain.ReviewerAssignment{
+					ReviewerID: reviewerID,
+					AssignedAt: assignedAt,
+				})
+			}
+
+		case "":
+			continue
+		default:
+			return domain.LegacyImport{}, fmt.Errorf("line %d: kind must be member or pull_request, got %q", line, kind)
+		}
+	}
+	return data, nil
+}
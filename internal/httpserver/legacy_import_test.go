@@ -0,0 +1,63 @@
+package httpserver
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeLegacyCSV(t *testing.T) {
+	body := "\ufeffkind,team_name,user_id,username,is_active,seniority,pull_request_id,pull_request_name,author_id,status,created_at,reviewer_id,assigned_at\n" +
+		"member,backend,u1,Alice,true,senior,,,,,,,\n" +
+		"member,backend,u2,Bob,false,,,,,,,,\n" +
+		"member,payments,u3,Carol,,,,,,,,,\n" +
+		",,,,,,,,,,,,\n" +
+		"pull_request,,,,,,pr-1,Add search,u1,open,2025-09-01T10:00:00Z,u2,2025-09-01T11:00:00Z\n" +
+		"pull_request,,,,,,pr-1,Add search,u1,open,2025-09-01T10:00:00Z,u3,\n"
+
+	data, err := decodeLegacyCSV(io.NopCloser(strings.NewReader(body)))
+	if err != nil {
+		t.Fatalf("decodeLegacyCSV: %v", err)
+	}
+
+	if len(data.Teams) != 2 || data.Teams[0].Name != "backend" || len(data.Teams[0].Members) != 2 {
+		t.Fatalf("Teams = %+v, want backend with 2 members and payments", data.Teams)
+	}
+	if data.Teams[0].Members[1].IsActive || !data.Teams[1].Members[0].IsActive {
+		t.Errorf("is_active not parsed: %+v", data.Teams)
+	}
+
+	if len(data.PullRequests) != 1 {
+		t.Fatalf("PullRequests = %+v, want one", data.PullRequests)
+	}
+	pr := data.PullRequests[0]
+	if pr.Status != "OPEN" || !pr.CreatedAt.Equal(time.Date(2025, time.September, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("pull request = %+v", pr)
+	}
+	if len(pr.Assignments) != 2 || pr.Assignments[0].ReviewerID != "u2" || !pr.Assignments[1].AssignedAt.IsZero() {
+		t.Errorf("Assignments = %+v", pr.Assignments)
+	}
+}
+
+func TestDecodeLegacyCSVErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "missing kind column", body: "team_name,user_id\nbackend,u1\n", want: "kind column"},
+		{name: "unknown kind", body: "kind,user_id\nperson,u1\n", want: "line 2"},
+		{name: "bad is_active", body: "kind,is_active\nmember,maybe\n", want: "is_active"},
+		{name: "bad time", body: "kind,pull_request_id,created_at\npull_request,pr-1,yesterday\n", want: "created_at"},
+		{name: "empty body", body: "", want: "header"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeLegacyCSV(io.NopCloser(strings.NewReader(tt.body)))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
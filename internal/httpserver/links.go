@@ -0,0 +1,32 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+)
+
+func (h *handler) handlePullRequestLink(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID          string `json:"pull_request_id"`
+		BlockedByID string `json:"blocked_by_id"`
+		Remove      bool   `json:"remove"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.ID == "" || req.BlockedByID == "" {
+		writeValidationError(w, errors.New("pull_request_id and blocked_by_id are required"))
+		return
+	}
+
+	pr, err := h.pullRequests.LinkPullRequests(r.Context(), req.ID, req.BlockedByID, !req.Remove)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pr": mapPullRequest(pr),
+	})
+}
@@ -0,0 +1,31 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+)
+
+func (h *handler) handleTeamMentoring(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName string `json:"team_name"`
+		Enabled  *bool  `json:"enabled"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.TeamName == "" || req.Enabled == nil {
+		writeValidationError(w, errors.New("team_name and enabled are required"))
+		return
+	}
+
+	team, err := h.teams.SetMentoringShadows(r.Context(), req.TeamName, *req.Enabled)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team": mapTeam(team),
+	})
+}
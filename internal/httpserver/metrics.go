@@ -0,0 +1,111 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/buildinfo"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpclient"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/storage/postgres"
+)
+
+func (h *handler) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	info := buildinfo.Get()
+	labels := fmt.Sprintf(`version=%q`, info.Version)
+
+	var b strings.Builder
+	writeGauge(&b, "pr_reviewer_build_info", "Build information of the running binary.",
+		fmt.Sprintf(`version=%q,commit=%q,go_version=%q`, info.Version, info.Commit, info.GoVersion), 1)
+	writeCounter(&b, "pr_reviewer_http_panics_total", "Panics recovered while handling HTTP requests.", labels, h.panics.Load())
+	writeCounter(&b, "pr_reviewer_requests_shed_total", "Requests rejected with 503 by the load shedder.", labels, h.shedder.shed.Load())
+	writeCounter(&b, "pr_reviewer_db_pool_exhausted_total", "Requests answered with 503 POOL_EXHAUSTED because no database connection was acquired in time.", labels, h.poolExhausted.Load())
+	hits, misses := h.admin.MemberSnapshotStats()
+	writeCounter(&b, "pr_reviewer_team_snapshot_hits_total", "Reviewer selections served from the cached team member snapshot.", labels, hits)
+	writeCounter(&b, "pr_reviewer_team_snapshot_misses_total", "Reviewer selections that loaded team members from the database.", labels, misses)
+	hits, misses = h.admin.PullRequestCacheStats()
+	writeCounter(&b, "pr_reviewer_pull_request_cache_hits_total", "Pull request reads served from the service cache.", labels, hits)
+	writeCounter(&b, "pr_reviewer_pull_request_cache_misses_total", "Pull request reads that loaded the pull request from the database.", labels, misses)
+	samples, diverged := h.admin.ShadowAssignmentStats()
+	writeCounter(&b, "pr_reviewer_shadow_assignments_total", "Pull requests for which a shadow reviewer assignment was recorded.", labels, samples)
+	writeCounter(&b, "pr_reviewer_shadow_assignment_divergences_total", "Shadow reviewer assignments that picked different reviewers than the primary strategy.", labels, diverged)
+	if gauges, ok := h.admin.InvariantGauges(); ok {
+		writeGauge(&b, "pr_reviewer_teams_without_active_members", "Teams that have no active members.", labels, gauges.TeamsWithoutActiveMembers)
+		writeGauge(&b, "pr_reviewer_understaffed_open_pull_requests", "Open pull requests with fewer reviewers than the team quorum requires.", labels, gauges.UnderstaffedPullRequests)
+		writeGauge(&b, "pr_reviewer_inactive_assigned_reviewers", "Inactive users assigned to open pull requests.", labels, gauges.InactiveAssignedReviewers)
+		writeGauge(&b, "pr_reviewer_invariants_collected_timestamp_seconds", "Unix time of the last invariant collection.", labels, gauges.CollectedAt.Unix())
+	}
+
+	writeStatementMetrics(&b, labels, &h.statements)
+	if h.outbound != nil {
+		writeOutboundMetrics(&b, labels, h.outbound.Stats())
+	}
+	if h.queries != nil {
+		writeQueryDurations(&b, labels, h.queries.Snapshot())
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(b.String()))
+}
+
+func writeGauge(b *strings.Builder, name, help, labels string, value int64) {
+	writeMetric(b, name, "gauge", help, labels, value)
+}
+
+func writeCounter(b *strings.Builder, name, help, labels string, value int64) {
+	writeMetric(b, name, "counter", help, labels, value)
+}
+
+func writeMetric(b *strings.Builder, name, kind, help, labels string, value int64) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n%s{%s} %d\n", name, help, name, kind, name, labels, value)
+}
+
+func writeOutboundMetrics(b *strings.Builder, labels string, stats []httpclient.Stats) {
+	if len(stats) == 0 {
+		return
+	}
+
+	families := []struct {
+		name  string
+		kind  string
+		help  string
+		value func(httpclient.Stats) int64
+	}{
+		{"pr_reviewer_outbound_requests_total", "counter", "Outbound HTTP requests per integration, excluding retries.", func(s httpclient.Stats) int64 { return s.Requests }},
+		{"pr_reviewer_outbound_failures_total", "counter", "Outbound HTTP requests that failed after all retries.", func(s httpclient.Stats) int64 { return s.Failures }},
+		{"pr_reviewer_outbound_retries_total", "counter", "Outbound HTTP retry attempts.", func(s httpclient.Stats) int64 { return s.Retries }},
+		{"pr_reviewer_outbound_retries_dropped_total", "counter", "Outbound HTTP retries skipped because the retry budget was exhausted.", func(s httpclient.Stats) int64 { return s.RetriesDropped }},
+		{"pr_reviewer_outbound_rejected_total", "counter", "Outbound HTTP requests rejected by an open circuit breaker.", func(s httpclient.Stats) int64 { return s.Rejected }},
+		{"pr_reviewer_outbound_breaker_open", "gauge", "Whether the integration circuit breaker is open.", func(s httpclient.Stats) int64 {
+			if s.BreakerOpen {
+				return 1
+			}
+			return 0
+		}},
+	}
+	for _, f := range families {
+		fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.kind)
+		for _, s := range stats {
+			fmt.Fprintf(b, "%s{%s,client=%q} %d\n", f.name, labels, s.Name, f.value(s))
+		}
+	}
+}
+
+func writeQueryDurations(b *strings.Builder, labels string, queries []postgres.QueryDurations) {
+	if len(queries) == 0 {
+		return
+	}
+
+	const name = "pr_reviewer_db_query_duration_seconds"
+	fmt.Fprintf(b, "# HELP %s Database statement latency per query name (operation and first table).\n# TYPE %s histogram\n", name, name)
+	for _, q := range queries {
+		for i, bound := range postgres.QueryDurationBuckets {
+			fmt.Fprintf(b, "%s_bucket{%s,query=%q,le=\"%s\"} %d\n", name, labels, q.Name, strconv.FormatFloat(bound, 'g', -1, 64), q.Buckets[i])
+		}
+		fmt.Fprintf(b, "%s_bucket{%s,query=%q,le=\"+Inf\"} %d\n", name, labels, q.Name, q.Count)
+		fmt.Fprintf(b, "%s_sum{%s,query=%q} %s\n", name, labels, q.Name, strconv.FormatFloat(q.Sum.Seconds(), 'f', -1, 64))
+		fmt.Fprintf(b, "%s_count{%s,query=%q} %d\n", name, labels, q.Name, q.Count)
+	}
+}
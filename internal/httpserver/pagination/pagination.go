@@ -0,0 +1,122 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+var ErrInvalidCursor = errors.New("cursor is invalid or does not match the requested sort")
+
+type Options struct {
+	DefaultLimit int
+	MaxLimit     int
+	SortFields   []string
+	DefaultSort  string
+}
+
+type Params struct {
+	Limit  int
+	Offset int
+	SortBy string
+	Desc   bool
+}
+
+type cursor struct {
+	Offset int    `json:"o"`
+	Sort   string `json:"s"`
+}
+
+func Parse(query url.Values, opts Options) (Params, error) {
+	params := Params{Limit: opts.DefaultLimit}
+
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			return Params{}, errors.New("limit must be a positive integer")
+		}
+		params.Limit = limit
+	}
+	if opts.MaxLimit > 0 && params.Limit > opts.MaxLimit {
+		return Params{}, fmt.Errorf("limit must not exceed %d", opts.MaxLimit)
+	}
+
+	sort := query.Get("sort")
+	if sort == "" {
+		sort = opts.DefaultSort
+	}
+	field := strings.TrimPrefix(sort, "-")
+	if !contains(opts.SortFields, field) {
+		return Params{}, fmt.Errorf("sort must be one of %s (prefix with - for descending)", strings.Join(opts.SortFields, ", "))
+	}
+	params.SortBy = field
+	params.Desc = strings.HasPrefix(sort, "-")
+
+	if raw := query.Get("cursor"); raw != "" {
+		c, err := decodeCursor(raw)
+		if err != nil || c.Sort != sort || c.Offset < 0 {
+			return Params{}, ErrInvalidCursor
+		}
+		params.Offset = c.Offset
+	}
+
+	return params, nil
+}
+
+func (p Params) Page() domain.Page {
+	page := domain.Page{
+		Offset: p.Offset,
+		SortBy: p.SortBy,
+		Desc:   p.Desc,
+	}
+	if p.Limit > 0 {
+		page.Limit = p.Limit + 1
+	}
+	return page
+}
+
+func Trim[T any](p Params, items []T) ([]T, string) {
+	if p.Limit <= 0 || len(items) <= p.Limit {
+		return items, ""
+	}
+	return items[:p.Limit], encodeCursor(cursor{Offset: p.Offset + p.Limit, Sort: p.sort()})
+}
+
+func (p Params) sort() string {
+	if p.Desc {
+		return "-" + p.SortBy
+	}
+	return p.SortBy
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(raw string) (cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return cursor{}, err
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return cursor{}, err
+	}
+	return c, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
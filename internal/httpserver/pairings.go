@@ -0,0 +1,95 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleStatsPairings(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+	periodName := strings.TrimSpace(r.URL.Query().Get("period"))
+	period, ok := leaderboardPeriods[periodName]
+	if !ok {
+		writeValidationError(w, errors.New("period must be one of week, month, all"))
+		return
+	}
+	if periodName == "" {
+		periodName = "all"
+	}
+	format := strings.TrimSpace(r.URL.Query().Get("format"))
+	if format != "" && format != "json" && format != "dot" {
+		writeValidationError(w, errors.New("format must be one of json, dot"))
+		return
+	}
+
+	graph, err := h.stats.GetPairingGraph(r.Context(), teamName, period)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	if format == "dot" {
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(encodePairingsDOT(graph)))
+		return
+	}
+
+	nodes := make([]map[string]any, 0, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		nodes = append(nodes, map[string]any{
+			"user_id":   n.UserID,
+			"username":  n.Username,
+			"is_member": n.IsMember,
+		})
+	}
+	edges := make([]map[string]any, 0, len(graph.Edges))
+	for _, e := range graph.Edges {
+		edges = append(edges, map[string]any{
+			"author_id":   e.AuthorID,
+			"reviewer_id": e.ReviewerID,
+			"reviews":     e.Reviews,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team_name": graph.TeamName,
+		"period":    periodName,
+		"nodes":     nodes,
+		"edges":     edges,
+	})
+}
+
+func encodePairingsDOT(g domain.PairingGraph) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(g.TeamName))
+	b.WriteString("  node [shape=box];\n")
+	for _, n := range g.Nodes {
+		label := n.UserID
+		if n.Username != "" {
+			label = n.Username
+		}
+		style := ""
+		if !n.IsMember {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %s [label=%s%s];\n", dotQuote(n.UserID), dotQuote(label), style)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [weight=%d, label=\"%d\"];\n", dotQuote(e.AuthorID), dotQuote(e.ReviewerID), e.Reviews, e.Reviews)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
@@ -0,0 +1,104 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handlePolicyEffective(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+
+	resolution, err := h.admin.GetEffectivePolicy(r.Context(), teamName, r.URL.Query().Get("pull_request_id"))
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	policies := make([]map[string]any, 0, len(resolution.Policies))
+	for _, p := range resolution.Policies {
+		layers := make([]map[string]any, 0, len(p.Layers))
+		for _, l := range p.Layers {
+			layers = append(layers, map[string]any{
+				"source": l.Source,
+				"value":  l.Value,
+			})
+		}
+		policies = append(policies, map[string]any{
+			"key":    p.Key,
+			"value":  p.Value,
+			"source": p.Source,
+			"layers": layers,
+		})
+	}
+
+	resp := map[string]any{
+		"team_name": resolution.TeamName,
+		"policies":  policies,
+	}
+	if resolution.PullRequestID != "" {
+		resp["pull_request_id"] = resolution.PullRequestID
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *handler) handleAdminPolicySet(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "policy overrides can be changed only by trusted callers")
+		return
+	}
+
+	var req struct {
+		Scope         string  `json:"scope"`
+		TeamName      string  `json:"team_name"`
+		PullRequestID string  `json:"pull_request_id"`
+		Key           string  `json:"key"`
+		Value         *string `json:"value"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.Scope == "" || req.Key == "" {
+		writeValidationError(w, errors.New("scope and key are required"))
+		return
+	}
+	value := ""
+	if req.Value != nil {
+		if *req.Value == "" {
+			writeValidationError(w, errors.New("value must not be empty; send null to remove the override"))
+			return
+		}
+		value = *req.Value
+	}
+
+	override, err := h.admin.SetPolicyOverride(r.Context(), domain.PolicySource(req.Scope), req.TeamName, req.PullRequestID, domain.PolicyKey(req.Key), value)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	resp := map[string]any{
+		"scope": override.Scope,
+		"key":   override.Key,
+	}
+	if override.TeamName != "" {
+		resp["team_name"] = override.TeamName
+	}
+	if override.PullRequestID != "" {
+		resp["pull_request_id"] = override.PullRequestID
+	}
+	if override.Value != "" {
+		resp["value"] = override.Value
+		resp["updated_at"] = formatTime(override.UpdatedAt)
+	} else {
+		resp["removed"] = true
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
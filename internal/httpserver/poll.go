@@ -0,0 +1,33 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+func (h *handler) handleUserGetReviewPoll(w http.ResponseWriter, r *http.Request) {
+	userID := strings.TrimSpace(r.URL.Query().Get("user_id"))
+	if userID == "" {
+		writeValidationError(w, errors.New("user_id query parameter is required"))
+		return
+	}
+	since := strings.TrimSpace(r.URL.Query().Get("since"))
+
+	token, prs, heldUntil, err := h.users.WaitReviewChange(r.Context(), userID, since)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	resp := map[string]any{
+		"user_id":       userID,
+		"token":         token,
+		"changed":       token != since,
+		"pull_requests": mapPullRequestShortList(prs),
+	}
+	if heldUntil != nil {
+		resp["held_until"] = formatTime(*heldUntil)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
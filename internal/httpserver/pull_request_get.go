@@ -0,0 +1,25 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+func (h *handler) handlePullRequestGet(w http.ResponseWriter, r *http.Request) {
+	prID := strings.TrimSpace(r.URL.Query().Get("pull_request_id"))
+	if prID == "" {
+		writeValidationError(w, errors.New("pull_request_id query parameter is required"))
+		return
+	}
+
+	pr, err := h.pullRequests.GetPullRequest(r.Context(), prID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pr": mapPullRequest(pr),
+	})
+}
@@ -0,0 +1,55 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleTeamQuorum(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName string `json:"team_name"`
+		Rules    []struct {
+			Seniority    string `json:"seniority"`
+			MinReviewers int    `json:"min_reviewers"`
+		} `json:"rules"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.TeamName == "" {
+		writeValidationError(w, errors.New("team_name is required"))
+		return
+	}
+
+	rules := make([]domain.QuorumRule, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		rules = append(rules, domain.QuorumRule{
+			Seniority:    domain.Seniority(rule.Seniority),
+			MinReviewers: rule.MinReviewers,
+		})
+	}
+
+	team, err := h.teams.SetTeamQuorum(r.Context(), req.TeamName, rules)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team": mapTeam(team),
+	})
+}
+
+func mapQuorumRules(rules []domain.QuorumRule) []map[string]any {
+	result := make([]map[string]any, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]any{
+			"seniority":     string(rule.Seniority),
+			"min_reviewers": rule.MinReviewers,
+		})
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+)
+
+func (h *handler) handleTeamRampUp(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName string `json:"team_name"`
+		Days     *int   `json:"days"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.TeamName == "" || req.Days == nil {
+		writeValidationError(w, errors.New("team_name and days are required"))
+		return
+	}
+
+	team, err := h.teams.SetTeamRampUp(r.Context(), req.TeamName, *req.Days)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team": mapTeam(team),
+	})
+}
@@ -0,0 +1,36 @@
+package httpserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+)
+
+const (
+	readConsistencyHeader = "X-Read-Consistency"
+
+	readConsistencyStrong   = "strong"
+	readConsistencyEventual = "eventual"
+)
+
+func (h *handler) routeReads(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		consistency := h.readConsistency
+		switch requested := strings.ToLower(strings.TrimSpace(r.Header.Get(readConsistencyHeader))); requested {
+		case readConsistencyStrong, readConsistencyEventual:
+			consistency = requested
+		}
+		w.Header().Set(readConsistencyHeader, consistency)
+
+		if consistency == readConsistencyEventual {
+			r = r.WithContext(ctxutil.WithReplicaReads(r.Context()))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,38 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/health"
+)
+
+func (h *handler) handleHealthReady(w http.ResponseWriter, r *http.Request) {
+	report := health.Report{Ready: true}
+	if h.health != nil {
+		report = h.health.Check(r.Context())
+	}
+
+	dependencies := make([]map[string]any, 0, len(report.Dependencies))
+	for _, d := range report.Dependencies {
+		dep := map[string]any{
+			"name":        d.Name,
+			"status":      "up",
+			"critical":    d.Critical,
+			"duration_ms": d.Duration.Milliseconds(),
+		}
+		if d.Err != nil {
+			dep["status"] = "down"
+			dep["error"] = d.Err.Error()
+		}
+		dependencies = append(dependencies, dep)
+	}
+
+	status, code := http.StatusOK, "ready"
+	if !report.Ready {
+		status, code = http.StatusServiceUnavailable, "not_ready"
+	}
+	writeJSON(w, status, map[string]any{
+		"status":       code,
+		"dependencies": dependencies,
+	})
+}
@@ -0,0 +1,61 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleUserReassignAll(w http.ResponseWriter, r *http.Request) {
+	r, dryRun := withDryRun(r)
+
+	var req struct {
+		UserID string `json:"user_id"`
+		Reason string `json:"reason"`
+		Note   string `json:"note"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.UserID == "" {
+		writeValidationError(w, errors.New("user_id is required"))
+		return
+	}
+
+	report, err := h.pullRequests.ReassignAll(r.Context(), req.UserID, domain.DeclineReason(req.Reason), req.Note)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	results := make([]map[string]any, 0, len(report.Results))
+	counts := map[domain.BulkReassignStatus]int{}
+	for _, result := range report.Results {
+		item := map[string]any{
+			"pull_request_id": result.PullRequestID,
+			"status":          result.Status,
+		}
+		if result.ReplacedBy != "" {
+			item["replaced_by"] = result.ReplacedBy
+		}
+		if result.Reason != "" {
+			item["reason"] = result.Reason
+		}
+		results = append(results, item)
+		counts[result.Status]++
+	}
+
+	resp := map[string]any{
+		"user_id":      report.UserID,
+		"results":      results,
+		"reassigned":   counts[domain.BulkReassignReassigned],
+		"no_candidate": counts[domain.BulkReassignNoCandidate],
+		"skipped":      counts[domain.BulkReassignSkipped],
+	}
+	if dryRun {
+		resp["dry_run"] = true
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
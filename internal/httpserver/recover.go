@@ -0,0 +1,38 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"go.uber.org/zap"
+)
+
+func (h *handler) recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+
+			h.panics.Add(1)
+			ctxutil.Logger(r.Context(), h.logger).Error("panic while handling request",
+				zap.String("panic", fmt.Sprint(rec)),
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.ByteString("stack", debug.Stack()),
+			)
+
+			if r.Header.Get("Connection") != "Upgrade" {
+				writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "internal server error")
+			}
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,80 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/replica"
+	"go.uber.org/zap"
+)
+
+func (h *handler) rejectWritesOnStandby(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !h.replica.Writable() && isWrite(r) {
+			writeError(w, http.StatusServiceUnavailable, "READ_ONLY", "this deployment is a standby replica, writes are disabled")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+var readOnlyPosts = map[string]bool{
+	"/admin/role":              true,
+	"/pullRequest/statusBatch": true,
+}
+
+func isWrite(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return !readOnlyPosts[r.URL.Path]
+	}
+}
+
+func (h *handler) handleHealthRole(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, mapReplicaState(h.replica))
+}
+
+func (h *handler) handleAdminRole(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "changing the replica role is allowed only for trusted callers")
+		return
+	}
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	role, err := replica.ParseRole(req.Role)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	if err := h.admin.SaveReplicaRole(r.Context(), h.replica.Region(), string(role)); err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	if previous := h.replica.SetRole(role); previous != role {
+		ctxutil.Logger(r.Context(), h.logger).Warn("replica role changed",
+			zap.String("region", h.replica.Region()),
+			zap.String("from", string(previous)),
+			zap.String("to", string(role)),
+		)
+	}
+
+	writeJSON(w, http.StatusOK, mapReplicaState(h.replica))
+}
+
+func mapReplicaState(state *replica.State) map[string]any {
+	return map[string]any{
+		"region":   state.Region(),
+		"role":     state.Role(),
+		"writable": state.Writable(),
+	}
+}
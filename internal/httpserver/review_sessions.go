@@ -0,0 +1,124 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleUserReviewSessionSuggest(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID   string `json:"user_id"`
+		MaxLines int    `json:"max_lines"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.UserID == "" {
+		writeValidationError(w, errors.New("user_id is required"))
+		return
+	}
+
+	session, err := h.users.SuggestReviewSession(r.Context(), req.UserID, req.MaxLines)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"session": mapReviewSession(session),
+	})
+}
+
+func (h *handler) handleUserReviewSessionGet(w http.ResponseWriter, r *http.Request) {
+	sessionID := strings.TrimSpace(r.URL.Query().Get("session_id"))
+	if sessionID == "" {
+		writeValidationError(w, errors.New("session_id query parameter is required"))
+		return
+	}
+
+	session, err := h.users.GetReviewSession(r.Context(), sessionID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"session": mapReviewSession(session),
+	})
+}
+
+func (h *handler) handleUserReviewSessionAccept(w http.ResponseWriter, r *http.Request) {
+	h.advanceReviewSession(w, r, domain.ReviewSessionAccepted)
+}
+
+func (h *handler) handleUserReviewSessionComplete(w http.ResponseWriter, r *http.Request) {
+	h.advanceReviewSession(w, r, domain.ReviewSessionCompleted)
+}
+
+func (h *handler) advanceReviewSession(w http.ResponseWriter, r *http.Request, status domain.ReviewSessionStatus) {
+	var req struct {
+		SessionID string `json:"session_id"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.SessionID == "" {
+		writeValidationError(w, errors.New("session_id is required"))
+		return
+	}
+
+	session, skipped, err := h.users.AdvanceReviewSession(r.Context(), req.SessionID, status)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+	if skipped == nil {
+		skipped = []string{}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"session": mapReviewSession(session),
+		"skipped": skipped,
+	})
+}
+
+func mapReviewSession(session domain.ReviewSession) map[string]any {
+	items := make([]map[string]any, 0, len(session.Items))
+	for _, item := range session.Items {
+		resp := map[string]any{
+			"pull_request_id":   item.PullRequestID,
+			"pull_request_name": item.Name,
+			"author_id":         item.AuthorID,
+			"estimated_lines":   item.EstimatedLines,
+		}
+		if item.ReviewDueAt != nil {
+			resp["reviewDueAt"] = formatTime(*item.ReviewDueAt)
+		}
+		if item.LinesChanged != nil {
+			resp["lines_changed"] = *item.LinesChanged
+		}
+		items = append(items, resp)
+	}
+
+	resp := map[string]any{
+		"session_id":      session.ID,
+		"reviewer_id":     session.ReviewerID,
+		"status":          string(session.Status),
+		"max_lines":       session.LineBudget,
+		"estimated_lines": session.EstimatedLines(),
+		"items":           items,
+		"createdAt":       formatTime(session.CreatedAt),
+	}
+	if session.AcceptedAt != nil {
+		resp["acceptedAt"] = formatTime(*session.AcceptedAt)
+	}
+	if session.CompletedAt != nil {
+		resp["completedAt"] = formatTime(*session.CompletedAt)
+	}
+	return resp
+}
@@ -0,0 +1,31 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+)
+
+func (h *handler) handlePullRequestApprove(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID         string `json:"pull_request_id"`
+		ReviewerID string `json:"reviewer_id"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.ID == "" || req.ReviewerID == "" {
+		writeValidationError(w, errors.New("pull_request_id and reviewer_id are required"))
+		return
+	}
+
+	pr, err := h.pullRequests.ApproveReview(r.Context(), req.ID, req.ReviewerID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pr": mapPullRequest(pr),
+	})
+}
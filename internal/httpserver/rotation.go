@@ -0,0 +1,97 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/service"
+)
+
+func (h *handler) handleTeamRotationGet(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+	weeks := service.DefaultRotationWeeks
+	if raw := r.URL.Query().Get("weeks"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			writeValidationError(w, errors.New("weeks must be an integer"))
+			return
+		}
+		weeks = parsed
+	}
+
+	rotation, err := h.teams.GetTeamRotation(r.Context(), teamName, weeks)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapTeamRotation(rotation))
+}
+
+func (h *handler) handleTeamRotationSet(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TeamName string   `json:"team_name"`
+		Members  []string `json:"members"`
+		StartsOn string   `json:"starts_on"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.TeamName == "" {
+		writeValidationError(w, errors.New("team_name is required"))
+		return
+	}
+	if len(req.Members) > 0 && req.StartsOn == "" {
+		writeValidationError(w, errors.New("starts_on is required when members are set"))
+		return
+	}
+
+	rotation, err := h.teams.SetTeamRotation(r.Context(), req.TeamName, req.Members, req.StartsOn)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapTeamRotation(rotation))
+}
+
+func mapTeamRotation(tr domain.TeamRotation) map[string]any {
+	members := make([]map[string]any, 0, len(tr.Rotation.Members))
+	for _, m := range tr.Rotation.Members {
+		members = append(members, map[string]any{
+			"user_id":  m.UserID,
+			"eligible": m.Eligible,
+		})
+	}
+
+	schedule := make([]map[string]any, 0, len(tr.Schedule))
+	for _, week := range tr.Schedule {
+		entry := map[string]any{
+			"week_start": week.WeekStart.Format(time.DateOnly),
+			"scheduled":  week.Scheduled,
+		}
+		if week.UserID != "" {
+			entry["on_duty"] = week.UserID
+		}
+		schedule = append(schedule, entry)
+	}
+
+	resp := map[string]any{
+		"team_name": tr.TeamName,
+		"members":   members,
+		"schedule":  schedule,
+	}
+	if !tr.Rotation.StartsOn.IsZero() {
+		resp["starts_on"] = tr.Rotation.StartsOn.Format(time.DateOnly)
+	}
+	return resp
+}
@@ -4,41 +4,145 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/service"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 )
 
-func newRouter(logger *zap.Logger, svc *service.Service) http.Handler {
+func newRouter(cfg Config, logger *zap.Logger, svc *service.Service) http.Handler {
+	shedder := newLoadShedder(cfg.LoadShed)
 	h := &handler{
-		svc:    svc,
-		logger: logger,
+		teams:        svc.Teams,
+		users:        svc.Users,
+		pullRequests: svc.PullRequests,
+		stats:        svc.Stats,
+		admin:        svc.Admin,
+		logger:       logger,
+		trustedToken: cfg.TrustedCallerToken,
+		shedder:      shedder,
+		replica:      cfg.Replica,
+		health:       cfg.Health,
+		outbound:     cfg.Outbound,
+		queries:      cfg.QueryDurations,
+
+		readConsistency: cfg.ReadConsistency,
 	}
+	return h.routes()
+}
 
+func (h *handler) routes() http.Handler {
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
+	r.Use(traceContext)
+	r.Use(h.requestContext)
+	r.Use(h.trackStatements(r))
+	r.Use(h.routeReads)
+	r.Use(securityHeaders)
 	r.Use(middleware.RealIP)
-	r.Use(middleware.Recoverer)
+	r.Use(h.recoverer)
+	r.Use(rejectUnsupportedMethods(r))
+	r.Use(h.shedder.middleware)
+	r.Use(h.rejectWritesOnStandby)
 	r.Use(middleware.Timeout(30 * time.Second))
-	r.Use(zapRequestLogger(logger))
+	r.Use(zapRequestLogger(h.logger))
+	r.Use(negotiateVersion)
+
+	r.NotFound(notFoundHandler)
+	r.MethodNotAllowed(methodNotAllowedHandler(r))
 
 	r.Get("/health", h.handleHealth)
+	r.Get("/health/role", h.handleHealthRole)
+	r.Get("/health/ready", h.handleHealthReady)
+	r.Get("/metrics", h.handleMetrics)
+	r.Get("/version", h.handleVersion)
+	r.Get("/ui", h.handleUI)
+	r.Get("/policy/effective", h.handlePolicyEffective)
+	r.Get("/sync/changes", h.handleSyncChanges)
 
 	r.Route("/team", func(r chi.Router) {
+		r.Put("/", h.handleTeamPut)
 		r.Post("/add", h.handleTeamAdd)
+		r.Post("/update", h.handleTeamUpdate)
 		r.Get("/get", h.handleTeamGet)
+		r.Get("/search", h.handleTeamSearch)
+		r.Post("/apply", h.handleTeamApply)
+		r.Post("/checklist", h.handleTeamChecklist)
+		r.Get("/calendar", h.handleTeamCalendarGet)
+		r.Post("/calendar", h.handleTeamCalendarSet)
+		r.Post("/quorum", h.handleTeamQuorum)
+		r.Post("/uniquePrNames", h.handleTeamUniquePRNames)
+		r.Post("/rampUp", h.handleTeamRampUp)
+		r.Post("/mentoring", h.handleTeamMentoring)
+		r.Post("/assignmentStrategy", h.handleTeamAssignmentStrategy)
+		r.Get("/rotation", h.handleTeamRotationGet)
+		r.Post("/rotation", h.handleTeamRotationSet)
 	})
 
 	r.Route("/users", func(r chi.Router) {
+		r.Patch("/", h.handleUserPatch)
 		r.Post("/setIsActive", h.handleUserSetActive)
+		r.Post("/reassignAll", h.handleUserReassignAll)
 		r.Get("/getReview", h.handleUserGetReview)
+		r.Get("/getReview/poll", h.handleUserGetReviewPoll)
+		r.Get("/myQueue", h.handleUserMyQueue)
+		r.Post("/setLeaderboardOptOut", h.handleUserSetLeaderboardOptOut)
+		r.Get("/focusWindows", h.handleUserFocusWindowsGet)
+		r.Post("/focusWindows", h.handleUserFocusWindowsSet)
+		r.Get("/workingHours", h.handleUserWorkingHoursGet)
+		r.Post("/workingHours", h.handleUserWorkingHoursSet)
+		r.Post("/reviewSession", h.handleUserReviewSessionSuggest)
+		r.Get("/reviewSession", h.handleUserReviewSessionGet)
+		r.Post("/reviewSession/accept", h.handleUserReviewSessionAccept)
+		r.Post("/reviewSession/complete", h.handleUserReviewSessionComplete)
 	})
 
 	r.Route("/pullRequest", func(r chi.Router) {
+		r.Get("/get", h.handlePullRequestGet)
 		r.Post("/create", h.handlePullRequestCreate)
 		r.Post("/merge", h.handlePullRequestMerge)
 		r.Post("/reassign", h.handlePullRequestReassign)
+		r.Post("/declareConflict", h.handlePullRequestDeclareConflict)
+		r.Post("/delete", h.handlePullRequestDelete)
+		r.Post("/restore", h.handlePullRequestRestore)
+		r.Post("/checklist", h.handlePullRequestChecklist)
+		r.Post("/approve", h.handlePullRequestApprove)
+		r.Post("/snooze", h.handlePullRequestSnooze)
+		r.Post("/invalidateApprovals", h.handlePullRequestInvalidateApprovals)
+		r.Post("/link", h.handlePullRequestLink)
+		r.Post("/statusBatch", h.handlePullRequestStatusBatch)
+		r.Get("/timeline", h.handlePullRequestTimeline)
+	})
+
+	r.Route("/stats", func(r chi.Router) {
+		r.Get("/assignmentShadow", h.handleStatsAssignmentShadow)
+		r.Get("/firstResponse", h.handleStatsFirstResponse)
+		r.Get("/leaderboard", h.handleStatsLeaderboard)
+		r.Get("/forecast", h.handleStatsForecast)
+		r.Get("/aging", h.handleStatsAging)
+		r.Get("/declineReasons", h.handleStatsDeclineReasons)
+		r.Get("/pairings", h.handleStatsPairings)
+	})
+
+	r.Route("/admin", func(r chi.Router) {
+		r.Get("/consistency", h.handleAdminConsistency)
+		r.Get("/dbstats", h.handleAdminDBStats)
+		r.Get("/overview", h.handleAdminOverview)
+		r.Post("/importLegacy", h.handleAdminImportLegacy)
+		r.Get("/absenceSync", h.handleAdminAbsenceSync)
+		r.Get("/exports", h.handleAdminExports)
+		r.Get("/reviewerExclusions", h.handleAdminExclusionsList)
+		r.Post("/reviewerExclusions", h.handleAdminExclusionsAdd)
+		r.Post("/reviewerExclusions/remove", h.handleAdminExclusionsRemove)
+		r.Get("/conflicts", h.handleAdminConflicts)
+		r.Post("/policy", h.handleAdminPolicySet)
+		r.Post("/role", h.handleAdminRole)
+		r.Get("/announcements", h.handleAdminAnnouncementsList)
+		r.Post("/announcements", h.handleAdminAnnouncementsCreate)
+		r.Get("/assignmentFreezes", h.handleAdminFreezesList)
+		r.Post("/assignmentFreezes", h.handleAdminFreezesCreate)
+		r.Post("/assignmentFreezes/lift", h.handleAdminFreezesLift)
 	})
 
 	return r
@@ -58,8 +162,23 @@ func zapRequestLogger(logger *zap.Logger) func(http.Handler) http.Handler {
 				zap.String("path", r.URL.Path),
 				zap.Int("status", ww.Status()),
 				zap.Duration("duration", time.Since(start)),
-				zap.String("request_id", middleware.GetReqID(r.Context())),
+				zap.String("request_id", ctxutil.RequestID(r.Context())),
+				zap.String("trace_id", ctxutil.TraceFrom(r.Context()).TraceID),
 			)
 		})
 	}
 }
+
+func (h *handler) requestContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := middleware.GetReqID(r.Context())
+		w.Header().Set(middleware.RequestIDHeader, requestID)
+		ctx := ctxutil.WithRequestID(r.Context(), requestID)
+		ctx = ctxutil.WithLogger(ctx, h.logger.With(
+			zap.String("request_id", requestID),
+			zap.String("trace_id", ctxutil.TraceFrom(ctx).TraceID),
+		))
+		ctx = ctxutil.WithCaller(ctx, h.identifyCaller(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -6,19 +6,34 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/health"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpclient"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/replica"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/service"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/storage/postgres"
 	"go.uber.org/zap"
 )
 
+type Config struct {
+	Port               string
+	TrustedCallerToken func() string
+	LoadShed           LoadShedConfig
+	Replica            *replica.State
+	ReadConsistency    string
+	Health             *health.Registry
+	Outbound           *httpclient.Registry
+	QueryDurations     *postgres.QueryHistogram
+}
+
 type Server struct {
 	srv    *http.Server
 	logger *zap.Logger
 }
 
-func New(port string, logger *zap.Logger, svc *service.Service) *Server {
+func New(cfg Config, logger *zap.Logger, svc *service.Service) *Server {
 	httpSrv := &http.Server{
-		Addr:              ":" + port,
-		Handler:           newRouter(logger, svc),
+		Addr:              ":" + cfg.Port,
+		Handler:           newRouter(cfg, logger, svc),
 		ReadTimeout:       15 * time.Second,
 		ReadHeaderTimeout: 5 * time.Second,
 		WriteTimeout:      15 * time.Second,
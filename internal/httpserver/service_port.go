@@ -2,16 +2,99 @@ package httpserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
 )
 
-type Service interface {
-	CreateTeam(ctx context.Context, teamName string, members []domain.TeamMember) (domain.Team, error)
+type TeamService interface {
+	CreateTeam(ctx context.Context, teamName string, members []domain.TeamMember, reviewersRequired *int) (domain.Team, error)
+	UpdateTeam(ctx context.Context, teamName string, reviewersRequired *int) (domain.Team, int, error)
 	GetTeam(ctx context.Context, teamName string) (domain.Team, error)
+	SearchTeams(ctx context.Context, filter domain.TeamSearchFilter, page domain.Page) ([]domain.TeamSearchResult, error)
+	GetTeamOverview(ctx context.Context, teamName string) (domain.Team, domain.TeamMemberCounts, error)
+	ListTeamMembers(ctx context.Context, teamID int64, page domain.Page) ([]domain.TeamMember, error)
+	StreamTeamMembers(ctx context.Context, teamID int64, fn func(domain.TeamMember) error) error
+	ApplyTeams(ctx context.Context, teams []domain.Team, dryRun bool) ([]domain.TeamPlan, error)
+	SetTeamChecklist(ctx context.Context, teamName string, titles []string, required bool) (domain.Team, error)
+	SetUniqueOpenPRNames(ctx context.Context, teamName string, enabled bool) (domain.Team, []domain.PullRequestNameConflict, error)
+	UpsertTeam(ctx context.Context, teamName string, members []domain.TeamMember) (domain.Team, domain.TeamPlan, error)
+	SetTeamRampUp(ctx context.Context, teamName string, days int) (domain.Team, error)
+	SetMentoringShadows(ctx context.Context, teamName string, enabled bool) (domain.Team, error)
+	SetTeamAssignmentStrategy(ctx context.Context, teamName string, strategy domain.AssignmentStrategyKind) (domain.Team, error)
+	SetTeamQuorum(ctx context.Context, teamName string, rules []domain.QuorumRule) (domain.Team, error)
+	GetTeamCalendar(ctx context.Context, teamName string) (domain.TeamCalendar, error)
+	GetTeamRotation(ctx context.Context, teamName string, weeks int) (domain.TeamRotation, error)
+	SetTeamRotation(ctx context.Context, teamName string, members []string, startsOn string) (domain.TeamRotation, error)
+	SetTeamCalendar(ctx context.Context, teamName string, cal domain.Calendar) (domain.TeamCalendar, error)
+}
+
+type UserService interface {
 	SetUserActivity(ctx context.Context, userID string, isActive bool) (domain.User, error)
-	CreatePullRequest(ctx context.Context, prID, prName, authorID string) (domain.PullRequest, error)
-	MergePullRequest(ctx context.Context, prID string) (domain.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (domain.PullRequest, string, error)
-	ListReviewerPullRequests(ctx context.Context, userID string) ([]domain.PullRequestShort, error)
+	PatchUser(ctx context.Context, patch domain.UserPatch) (domain.User, domain.WorkingHours, error)
+	WaitReviewChange(ctx context.Context, userID, since string) (string, []domain.PullRequestShort, *time.Time, error)
+	ListReviewerPullRequests(ctx context.Context, userID string, page domain.Page, includeDeleted bool) ([]domain.PullRequestShort, error)
+	ListReviewQueue(ctx context.Context, userID string, hideBlocked bool, page domain.Page) ([]domain.PullRequestShort, error)
+	SetLeaderboardOptOut(ctx context.Context, userID string, optOut bool) error
+	GetFocusSchedule(ctx context.Context, userID string) (domain.FocusSchedule, error)
+	SetFocusSchedule(ctx context.Context, userID, timezone string, windows []domain.FocusWindow) (domain.FocusSchedule, error)
+	SuggestReviewSession(ctx context.Context, userID string, lineBudget int) (domain.ReviewSession, error)
+	GetReviewSession(ctx context.Context, sessionID string) (domain.ReviewSession, error)
+	AdvanceReviewSession(ctx context.Context, sessionID string, status domain.ReviewSessionStatus) (domain.ReviewSession, []string, error)
+	GetWorkingHours(ctx context.Context, userID string) (domain.WorkingHours, error)
+	SetWorkingHours(ctx context.Context, userID, timezone string, start, end time.Duration) (domain.WorkingHours, error)
+}
+
+type PullRequestService interface {
+	CreatePullRequest(ctx context.Context, prID, prName, authorID string, linesChanged *int) (domain.PullRequest, error)
+	GetPullRequest(ctx context.Context, prID string) (domain.PullRequest, error)
+	MergePullRequest(ctx context.Context, prID string, actor domain.MergeActor) (domain.PullRequest, error)
+	DeletePullRequest(ctx context.Context, prID string) (domain.PullRequest, error)
+	RestorePullRequest(ctx context.Context, prID string) (domain.PullRequest, error)
+	ReassignReviewer(ctx context.Context, prID, oldReviewerID string, reason domain.DeclineReason, note string) (domain.PullRequest, string, error)
+	ReassignAll(ctx context.Context, userID string, reason domain.DeclineReason, note string) (domain.BulkReassignReport, error)
+	DeclareConflict(ctx context.Context, prID, reviewerID string, category domain.ConflictCategory, details string) (domain.PullRequest, domain.ConflictDeclaration, error)
+	SetChecklistItem(ctx context.Context, prID, reviewerID string, itemID int64, checked bool) (domain.PullRequest, error)
+	ApproveReview(ctx context.Context, prID, reviewerID string) (domain.PullRequest, error)
+	GetPullRequestStatuses(ctx context.Context, prIDs []string) ([]domain.PullRequestStatusSummary, []string, error)
+	LinkPullRequests(ctx context.Context, prID, blockedByID string, linked bool) (domain.PullRequest, error)
+	SnoozeReview(ctx context.Context, prID, reviewerID string, until time.Time) (domain.PullRequest, error)
+	InvalidateApprovals(ctx context.Context, prID string) (domain.PullRequest, []string, error)
+	GetTimeline(ctx context.Context, prID string) (domain.PullRequestTimeline, error)
+}
+
+type StatsService interface {
+	GetAssignmentShadowReport(ctx context.Context, teamName string) (domain.AssignmentShadowReport, error)
+	GetLeaderboard(ctx context.Context, teamName string, period time.Duration) ([]domain.LeaderboardEntry, error)
+	GetFirstResponseStats(ctx context.Context, teamName string) ([]domain.FirstResponseStats, error)
+	GetReviewForecast(ctx context.Context, teamName string) (domain.ReviewForecast, error)
+	GetPullRequestAging(ctx context.Context, teamName string, days int) (domain.PullRequestAging, error)
+	GetDeclineReasonStats(ctx context.Context, teamName string, period time.Duration) (domain.DeclineReasonStats, error)
+	GetPairingGraph(ctx context.Context, teamName string, period time.Duration) (domain.PairingGraph, error)
+}
+
+type AdminService interface {
+	CheckConsistency(ctx context.Context) ([]domain.ConsistencyViolation, error)
+	GetDBStats(ctx context.Context) (domain.DBStats, error)
+	GetOverview(ctx context.Context) (domain.AdminOverview, error)
+	ImportLegacy(ctx context.Context, data domain.LegacyImport, dryRun bool) (domain.LegacyImportReport, error)
+	LastAbsenceSync() (domain.AbsenceSyncReport, bool)
+	ListHistoryExports(ctx context.Context) ([]domain.HistoryExport, error)
+	MemberSnapshotStats() (hits, misses int64)
+	PullRequestCacheStats() (hits, misses int64)
+	ShadowAssignmentStats() (samples, diverged int64)
+	SaveReplicaRole(ctx context.Context, region, role string) error
+	ListReviewerExclusions(ctx context.Context) ([]domain.ReviewerExclusion, error)
+	AddReviewerExclusion(ctx context.Context, userA, userB, reason string) (domain.ReviewerExclusion, error)
+	RemoveReviewerExclusion(ctx context.Context, userA, userB string) error
+	ListReviewerConflicts(ctx context.Context, page domain.Page) ([]domain.ConflictDeclaration, error)
+	GetEffectivePolicy(ctx context.Context, teamName, prID string) (domain.PolicyResolution, error)
+	ListChanges(ctx context.Context, after domain.SyncCursor, limit int) (domain.SyncPage, error)
+	SetPolicyOverride(ctx context.Context, scope domain.PolicySource, teamName, prID string, key domain.PolicyKey, value string) (domain.PolicyOverride, error)
+	InvariantGauges() (domain.InvariantGauges, bool)
+	ListAnnouncements(ctx context.Context) ([]domain.Announcement, error)
+	CreateAnnouncement(ctx context.Context, message string, severity domain.AnnouncementSeverity, startsAt, endsAt *time.Time) (domain.Announcement, error)
+	ListAssignmentFreezes(ctx context.Context) ([]domain.AssignmentFreeze, error)
+	CreateAssignmentFreeze(ctx context.Context, reason string, startsAt *time.Time, endsAt time.Time) (domain.AssignmentFreeze, error)
+	LiftAssignmentFreeze(ctx context.Context, freezeID int64) (domain.AssignmentFreeze, error)
 }
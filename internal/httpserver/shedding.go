@@ -0,0 +1,63 @@
+package httpserver
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type LoadShedConfig struct {
+	MaxInFlight       int64
+	MaxAcquireLatency time.Duration
+	RetryAfter        time.Duration
+	AcquireLatency    func() time.Duration
+}
+
+type loadShedder struct {
+	cfg      LoadShedConfig
+	inFlight atomic.Int64
+	shed     atomic.Int64
+}
+
+func newLoadShedder(cfg LoadShedConfig) *loadShedder {
+	return &loadShedder{cfg: cfg}
+}
+
+func (s *loadShedder) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inFlight := s.inFlight.Add(1)
+		defer s.inFlight.Add(-1)
+
+		if isLowPriority(r) && s.overloaded(inFlight) {
+			s.shed.Add(1)
+			w.Header().Set("Retry-After", strconv.Itoa(int(s.cfg.RetryAfter.Round(time.Second)/time.Second)))
+			writeError(w, http.StatusServiceUnavailable, "OVERLOADED", "service is overloaded, retry later")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *loadShedder) overloaded(inFlight int64) bool {
+	if s.cfg.MaxInFlight > 0 && inFlight > s.cfg.MaxInFlight {
+		return true
+	}
+	if s.cfg.MaxAcquireLatency > 0 && s.cfg.AcquireLatency != nil {
+		return s.cfg.AcquireLatency() > s.cfg.MaxAcquireLatency
+	}
+	return false
+}
+
+func isLowPriority(r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		return false
+	}
+	switch r.URL.Path {
+	case "/metrics", "/version":
+		return false
+	}
+	return !strings.HasPrefix(r.URL.Path, "/health")
+}
@@ -0,0 +1,68 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpserver/pagination"
+)
+
+func (h *handler) handlePullRequestSnooze(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID         string    `json:"pull_request_id"`
+		ReviewerID string    `json:"reviewer_id"`
+		Until      time.Time `json:"until"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.ID == "" || req.ReviewerID == "" || req.Until.IsZero() {
+		writeValidationError(w, errors.New("pull_request_id, reviewer_id and until are required"))
+		return
+	}
+
+	pr, err := h.pullRequests.SnoozeReview(r.Context(), req.ID, req.ReviewerID, req.Until.UTC())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pr": mapPullRequest(pr),
+	})
+}
+
+func (h *handler) handleUserMyQueue(w http.ResponseWriter, r *http.Request) {
+	userID := strings.TrimSpace(r.URL.Query().Get("user_id"))
+	if userID == "" {
+		writeValidationError(w, errors.New("user_id query parameter is required"))
+		return
+	}
+
+	hideBlocked := r.URL.Query().Get("hide_blocked") == "true"
+
+	params, err := pagination.Parse(r.URL.Query(), pullRequestListOptions("created_at"))
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	prs, err := h.users.ListReviewQueue(r.Context(), userID, hideBlocked, params.Page())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+	prs, next := pagination.Trim(params, prs)
+
+	resp := map[string]any{
+		"user_id":       userID,
+		"pull_requests": mapPullRequestShortList(prs),
+	}
+	if next != "" {
+		resp["next_cursor"] = next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,41 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handlePullRequestDelete(w http.ResponseWriter, r *http.Request) {
+	h.handlePullRequestLifecycle(w, r, h.pullRequests.DeletePullRequest)
+}
+
+func (h *handler) handlePullRequestRestore(w http.ResponseWriter, r *http.Request) {
+	h.handlePullRequestLifecycle(w, r, h.pullRequests.RestorePullRequest)
+}
+
+func (h *handler) handlePullRequestLifecycle(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, prID string) (domain.PullRequest, error)) {
+	var req struct {
+		ID string `json:"pull_request_id"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.ID == "" {
+		writeValidationError(w, errors.New("pull_request_id is required"))
+		return
+	}
+
+	pr, err := action(r.Context(), req.ID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pr": mapPullRequest(pr),
+	})
+}
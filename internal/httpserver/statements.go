@@ -0,0 +1,97 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/go-chi/chi/v5"
+)
+
+type endpointStatements struct {
+	requests     int64
+	queries      int64
+	rows         int64
+	microseconds int64
+}
+
+type statementStats struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointStatements
+}
+
+func (s *statementStats) record(endpoint string, session *ctxutil.DBSession) {
+	queries, rows, elapsed := session.Totals()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.endpoints == nil {
+		s.endpoints = make(map[string]*endpointStatements)
+	}
+	e, ok := s.endpoints[endpoint]
+	if !ok {
+		e = &endpointStatements{}
+		s.endpoints[endpoint] = e
+	}
+	e.requests++
+	e.queries += queries
+	e.rows += rows
+	e.microseconds += elapsed.Microseconds()
+}
+
+func (s *statementStats) snapshot() ([]string, map[string]endpointStatements) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, 0, len(s.endpoints))
+	values := make(map[string]endpointStatements, len(s.endpoints))
+	for name, e := range s.endpoints {
+		names = append(names, name)
+		values[name] = *e
+	}
+	slices.Sort(names)
+	return names, values
+}
+
+func (h *handler) trackStatements(routes chi.Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !routes.Match(chi.NewRouteContext(), r.Method, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session := &ctxutil.DBSession{}
+			next.ServeHTTP(w, r.WithContext(ctxutil.WithDBSession(r.Context(), session)))
+			h.statements.record(r.Method+" "+r.URL.Path, session)
+		})
+	}
+}
+
+func writeStatementMetrics(b *strings.Builder, labels string, stats *statementStats) {
+	names, values := stats.snapshot()
+	if len(names) == 0 {
+		return
+	}
+
+	families := []struct {
+		name  string
+		help  string
+		value func(endpointStatements) int64
+	}{
+		{"pr_reviewer_db_endpoint_requests_total", "Requests served per endpoint.", func(e endpointStatements) int64 { return e.requests }},
+		{"pr_reviewer_db_endpoint_queries_total", "Database round-trips issued per endpoint.", func(e endpointStatements) int64 { return e.queries }},
+		{"pr_reviewer_db_endpoint_rows_total", "Rows returned by the database per endpoint.", func(e endpointStatements) int64 { return e.rows }},
+		{"pr_reviewer_db_endpoint_time_microseconds_total", "Time spent waiting on database statements per endpoint.", func(e endpointStatements) int64 { return e.microseconds }},
+	}
+	for _, f := range families {
+		fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s counter\n", f.name, f.help, f.name)
+		for _, name := range names {
+			fmt.Fprintf(b, "%s{%s,endpoint=%q} %d\n", f.name, labels, name, f.value(values[name]))
+		}
+	}
+}
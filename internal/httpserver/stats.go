@@ -0,0 +1,240 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/service"
+)
+
+func (h *handler) handleStatsAssignmentShadow(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+
+	report, err := h.stats.GetAssignmentShadowReport(r.Context(), teamName)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	divergenceRate := 0.0
+	if report.Total > 0 {
+		divergenceRate = float64(report.Diverged) / float64(report.Total)
+	}
+
+	recent := make([]map[string]any, 0, len(report.Recent))
+	for _, sample := range report.Recent {
+		recent = append(recent, map[string]any{
+			"pull_request_id":   sample.PullRequestID,
+			"primary_reviewers": sample.PrimaryReviewers,
+			"shadow_reviewers":  sample.ShadowReviewers,
+			"diverged":          sample.Diverged,
+			"createdAt":         formatTime(sample.CreatedAt),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"total":           report.Total,
+		"diverged":        report.Diverged,
+		"divergence_rate": divergenceRate,
+		"recent":          recent,
+	})
+}
+
+func (h *handler) handleStatsFirstResponse(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+
+	stats, err := h.stats.GetFirstResponseStats(r.Context(), teamName)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	teams := make([]map[string]any, 0, len(stats))
+	for _, st := range stats {
+		teams = append(teams, map[string]any{
+			"team_name":                     st.TeamName,
+			"responded":                     st.Responded,
+			"pending":                       st.Pending,
+			"median_first_response_seconds": st.MedianFirstResponse.Seconds(),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"teams": teams,
+	})
+}
+
+var leaderboardPeriods = map[string]time.Duration{
+	"":      0,
+	"all":   0,
+	"week":  7 * 24 * time.Hour,
+	"month": 30 * 24 * time.Hour,
+}
+
+func (h *handler) handleStatsLeaderboard(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+	periodName := strings.TrimSpace(r.URL.Query().Get("period"))
+	period, ok := leaderboardPeriods[periodName]
+	if !ok {
+		writeValidationError(w, errors.New("period must be one of week, month, all"))
+		return
+	}
+	if periodName == "" {
+		periodName = "all"
+	}
+
+	entries, err := h.stats.GetLeaderboard(r.Context(), teamName, period)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	reviewers := make([]map[string]any, 0, len(entries))
+	for i, e := range entries {
+		reviewers = append(reviewers, map[string]any{
+			"rank":                          i + 1,
+			"user_id":                       e.UserID,
+			"username":                      e.Username,
+			"completed_reviews":             e.CompletedReviews,
+			"median_first_response_seconds": e.MedianFirstResponse.Seconds(),
+			"saves":                         e.Saves,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team_name": teamName,
+		"period":    periodName,
+		"reviewers": reviewers,
+	})
+}
+
+func (h *handler) handleUserSetLeaderboardOptOut(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID string `json:"user_id"`
+		OptOut *bool  `json:"opt_out"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.UserID == "" || req.OptOut == nil {
+		writeValidationError(w, errors.New("user_id and opt_out are required"))
+		return
+	}
+
+	if err := h.users.SetLeaderboardOptOut(r.Context(), req.UserID, *req.OptOut); err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"user_id": req.UserID,
+		"opt_out": *req.OptOut,
+	})
+}
+
+func (h *handler) handleStatsForecast(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+
+	forecast, err := h.stats.GetReviewForecast(r.Context(), teamName)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	members := make([]map[string]any, 0, len(forecast.Members))
+	for _, m := range forecast.Members {
+		members = append(members, map[string]any{
+			"user_id":           m.UserID,
+			"username":          m.Username,
+			"is_active":         m.IsActive,
+			"pending_reviews":   m.PendingReviews,
+			"overdue_reviews":   m.OverdueReviews,
+			"weekly_capacity":   m.WeeklyCapacity,
+			"projected_load":    m.ProjectedLoad,
+			"likely_sla_breach": m.LikelySLABreach,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team_name":            teamName,
+		"history_weeks":        forecast.HistoryWeeks,
+		"weekly_pull_requests": forecast.WeeklyPullRequests,
+		"reviewers_per_pr":     forecast.ReviewersPerPR,
+		"members":              members,
+		"warnings":             forecast.Warnings,
+	})
+}
+
+func (h *handler) handleStatsAging(w http.ResponseWriter, r *http.Request) {
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+	days := service.DefaultAgingHistoryDays
+	if raw := r.URL.Query().Get("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			writeValidationError(w, errors.New("days must be an integer"))
+			return
+		}
+		days = parsed
+	}
+
+	aging, err := h.stats.GetPullRequestAging(r.Context(), teamName, days)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	oldest := make([]map[string]any, 0, len(aging.Oldest))
+	for _, pr := range aging.Oldest {
+		oldest = append(oldest, map[string]any{
+			"pull_request_id":   pr.ID,
+			"pull_request_name": pr.Name,
+			"author_id":         pr.AuthorID,
+			"createdAt":         formatTime(pr.CreatedAt),
+			"age_hours":         int(aging.GeneratedAt.Sub(pr.CreatedAt).Hours()),
+		})
+	}
+
+	history := make([]map[string]any, 0, len(aging.History))
+	for _, s := range aging.History {
+		history = append(history, map[string]any{
+			"date":    s.Date.Format(time.DateOnly),
+			"total":   s.Buckets.Total(),
+			"buckets": mapAgeBuckets(s.Buckets),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"team_name":    aging.TeamName,
+		"generated_at": formatTime(aging.GeneratedAt),
+		"total":        aging.Buckets.Total(),
+		"buckets":      mapAgeBuckets(aging.Buckets),
+		"oldest":       oldest,
+		"history":      history,
+	})
+}
+
+func mapAgeBuckets(b domain.AgeBuckets) map[string]any {
+	return map[string]any{
+		"under_1d": b.Under1Day,
+		"1d_to_3d": b.From1To3Day,
+		"3d_to_7d": b.From3To7Day,
+		"over_7d":  b.Over7Day,
+	}
+}
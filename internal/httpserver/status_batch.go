@@ -0,0 +1,60 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const maxStatusBatch = 100
+
+func (h *handler) handlePullRequestStatusBatch(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		IDs []string `json:"pull_request_ids"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if len(req.IDs) == 0 {
+		writeValidationError(w, errors.New("pull_request_ids is required"))
+		return
+	}
+	if len(req.IDs) > maxStatusBatch {
+		writeValidationError(w, fmt.Errorf("pull_request_ids must contain at most %d items", maxStatusBatch))
+		return
+	}
+
+	summaries, notFound, err := h.pullRequests.GetPullRequestStatuses(r.Context(), req.IDs)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	result := make([]map[string]any, 0, len(summaries))
+	for _, s := range summaries {
+		pending := make([]string, 0, len(s.Reviewers))
+		responded := make(map[string]bool, len(s.Responded))
+		for _, id := range s.Responded {
+			responded[id] = true
+		}
+		for _, id := range s.Reviewers {
+			if !responded[id] {
+				pending = append(pending, id)
+			}
+		}
+		result = append(result, map[string]any{
+			"pull_request_id":   s.ID,
+			"status":            s.Status,
+			"reviewers":         len(s.Reviewers),
+			"responded":         len(s.Responded),
+			"pending_reviewers": pending,
+			"review_complete":   s.ReviewComplete(),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pull_requests": result,
+		"not_found":     notFound,
+	})
+}
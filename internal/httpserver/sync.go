@@ -0,0 +1,101 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+const (
+	syncDefaultLimit = 500
+	syncMaxLimit     = 5000
+)
+
+func (h *handler) handleSyncChanges(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "change feed is available only for trusted callers")
+		return
+	}
+
+	var after domain.SyncCursor
+	if raw := r.URL.Query().Get("since"); raw != "" {
+		parsed, err := domain.ParseSyncCursor(raw)
+		if err != nil {
+			writeValidationError(w, errors.New("since must be a cursor returned by a previous call"))
+			return
+		}
+		after = parsed
+	}
+	limit := syncDefaultLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > syncMaxLimit {
+			writeValidationError(w, errors.New("limit must be an integer between 1 and 5000"))
+			return
+		}
+		limit = parsed
+	}
+
+	page, err := h.admin.ListChanges(r.Context(), after, limit)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	changes := make([]map[string]any, 0, len(page.Changes))
+	for _, c := range page.Changes {
+		changes = append(changes, mapSyncChange(c))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"changes":     changes,
+		"next_cursor": page.Cursor.String(),
+		"has_more":    page.HasMore,
+	})
+}
+
+func mapSyncChange(c domain.SyncChange) map[string]any {
+	item := map[string]any{
+		"entity": c.Entity,
+		"id":     c.ID,
+		"op":     "upsert",
+	}
+	switch {
+	case c.Deleted:
+		item["op"] = "delete"
+	case c.Team != nil:
+		item["data"] = map[string]any{
+			"team_name":  c.Team.Name,
+			"member_ids": c.Team.MemberIDs,
+			"updated_at": formatTime(c.Team.UpdatedAt),
+		}
+	case c.User != nil:
+		data := map[string]any{
+			"user_id":    c.User.ID,
+			"username":   c.User.Username,
+			"is_active":  c.User.IsActive,
+			"updated_at": formatTime(c.User.UpdatedAt),
+		}
+		if c.User.TeamName != "" {
+			data["team_name"] = c.User.TeamName
+		}
+		item["data"] = data
+	case c.PullRequest != nil:
+		data := map[string]any{
+			"pull_request_id":    c.PullRequest.ID,
+			"pull_request_name":  c.PullRequest.Name,
+			"author_id":          c.PullRequest.AuthorID,
+			"status":             c.PullRequest.Status,
+			"assigned_reviewers": c.PullRequest.Reviewers,
+			"createdAt":          formatTime(c.PullRequest.CreatedAt),
+			"updatedAt":          formatTime(c.PullRequest.UpdatedAt),
+		}
+		if c.PullRequest.MergedAt != nil {
+			data["mergedAt"] = formatTime(*c.PullRequest.MergedAt)
+		}
+		item["data"] = data
+	}
+	return item
+}
@@ -0,0 +1,124 @@
+package httpserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpserver/pagination"
+	"go.uber.org/zap"
+)
+
+const teamStreamBufferSize = 32 << 10
+
+var teamMemberListOptions = pagination.Options{
+	MaxLimit:    1000,
+	SortFields:  []string{"username"},
+	DefaultSort: "username",
+}
+
+func (h *handler) handleTeamGet(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	teamName := strings.TrimSpace(query.Get("team_name"))
+	if teamName == "" {
+		writeValidationError(w, errors.New("team_name query parameter is required"))
+		return
+	}
+
+	params, err := pagination.Parse(url.Values{
+		"limit":  {query.Get("members_limit")},
+		"cursor": {query.Get("members_cursor")},
+	}, teamMemberListOptions)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	summary := query.Get("summary") == "true"
+	if query.Get("members_cursor") != "" && params.Limit == 0 {
+		writeValidationError(w, errors.New("members_cursor requires members_limit"))
+		return
+	}
+
+	team, counts, err := h.teams.GetTeamOverview(r.Context(), teamName)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	resp := mapTeamSettings(team)
+	switch {
+	case summary:
+		resp["member_count"] = counts.Total
+		resp["active_member_count"] = counts.Active
+		writeJSON(w, http.StatusOK, resp)
+	case params.Limit > 0:
+		members, err := h.teams.ListTeamMembers(r.Context(), team.ID, params.Page())
+		if err != nil {
+			h.writeServiceError(w, err)
+			return
+		}
+		members, next := pagination.Trim(params, members)
+
+		resp["members"] = mapTeamMembers(members)
+		resp["member_count"] = counts.Total
+		resp["active_member_count"] = counts.Active
+		if next != "" {
+			resp["members_next_cursor"] = next
+		}
+		writeJSON(w, http.StatusOK, resp)
+	default:
+		h.streamTeam(w, r, resp, team.ID)
+	}
+}
+
+func (h *handler) streamTeam(w http.ResponseWriter, r *http.Request, resp map[string]any, teamID int64) {
+	head, err := json.Marshal(resp)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	version := responseVersion(w)
+	w.Header().Set("Content-Type", version.contentType())
+	w.WriteHeader(http.StatusOK)
+
+	bw := bufio.NewWriterSize(w, teamStreamBufferSize)
+	if version != apiV1 {
+		_, _ = bw.WriteString(`{"data":`)
+	}
+	_, _ = bw.Write(head[:len(head)-1])
+	_, _ = bw.WriteString(`,"members":[`)
+
+	enc := json.NewEncoder(bw)
+	written := 0
+	err = h.teams.StreamTeamMembers(r.Context(), teamID, func(m domain.TeamMember) error {
+		if written > 0 {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		written++
+		return enc.Encode(mapTeamMember(m))
+	})
+	if err != nil {
+		ctxutil.Logger(r.Context(), h.logger).Error("team members stream aborted",
+			zap.Int64("team_id", teamID),
+			zap.Int("written", written),
+			zap.Error(err),
+		)
+		_ = bw.Flush()
+		panic(http.ErrAbortHandler)
+	}
+
+	_, _ = bw.WriteString("]}")
+	if version != apiV1 {
+		_, _ = bw.WriteString("}")
+	}
+	_, _ = bw.WriteString("\n")
+	_ = bw.Flush()
+}
@@ -0,0 +1,67 @@
+package httpserver
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/httpserver/pagination"
+)
+
+var teamSearchOptions = pagination.Options{
+	DefaultLimit: 20,
+	MaxLimit:     100,
+	SortFields:   []string{"relevance", "name", "size"},
+	DefaultSort:  "-relevance",
+}
+
+func (h *handler) handleTeamSearch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := domain.TeamSearchFilter{Query: query.Get("query")}
+	if raw := query.Get("has_active_members"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			writeValidationError(w, errors.New("has_active_members must be true or false"))
+			return
+		}
+		filter.HasActiveMembers = &parsed
+	}
+	if raw := query.Get("min_size"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			writeValidationError(w, errors.New("min_size must be an integer"))
+			return
+		}
+		filter.MinSize = parsed
+	}
+
+	params, err := pagination.Parse(query, teamSearchOptions)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	teams, err := h.teams.SearchTeams(r.Context(), filter, params.Page())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+	teams, next := pagination.Trim(params, teams)
+
+	items := make([]map[string]any, 0, len(teams))
+	for _, t := range teams {
+		items = append(items, map[string]any{
+			"team_name":      t.Name,
+			"members":        t.Members,
+			"active_members": t.ActiveMembers,
+			"score":          math.Round(t.Score*1000) / 1000,
+		})
+	}
+	resp := map[string]any{"teams": items}
+	if next != "" {
+		resp["next_cursor"] = next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
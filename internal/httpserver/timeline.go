@@ -0,0 +1,50 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handlePullRequestTimeline(w http.ResponseWriter, r *http.Request) {
+	prID := strings.TrimSpace(r.URL.Query().Get("pull_request_id"))
+	if prID == "" {
+		writeValidationError(w, errors.New("pull_request_id query parameter is required"))
+		return
+	}
+
+	timeline, err := h.pullRequests.GetTimeline(r.Context(), prID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	events := make([]map[string]any, 0, len(timeline.Events))
+	for _, e := range timeline.Events {
+		events = append(events, mapTimelineEvent(e))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"pull_request_id": timeline.PullRequestID,
+		"events":          events,
+	})
+}
+
+func mapTimelineEvent(e domain.TimelineEvent) map[string]any {
+	resp := map[string]any{
+		"at":   formatTime(e.At),
+		"kind": string(e.Kind),
+	}
+	if e.UserID != "" {
+		resp["user_id"] = e.UserID
+	}
+	if e.FromUserID != "" {
+		resp["from_user_id"] = e.FromUserID
+	}
+	if e.Detail != "" {
+		resp["detail"] = e.Detail
+	}
+	return resp
+}
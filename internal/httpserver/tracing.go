@@ -0,0 +1,87 @@
+package httpserver
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+)
+
+const (
+	traceparentHeader = "traceparent"
+	tracestateHeader  = "tracestate"
+	traceIDHeader     = "X-Trace-Id"
+
+	maxTracestateLength = 512
+)
+
+func traceContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		trace, ok := parseTraceparent(r.Header.Get(traceparentHeader))
+		if ok {
+			if state := r.Header.Get(tracestateHeader); len(state) <= maxTracestateLength {
+				trace.State = state
+			}
+		} else {
+			trace = ctxutil.Trace{TraceID: randomHex(16), Flags: "00"}
+		}
+		trace.SpanID = randomHex(8)
+
+		w.Header().Set(traceIDHeader, trace.TraceID)
+		w.Header().Set(traceparentHeader, formatTraceparent(trace))
+		if trace.State != "" {
+			w.Header().Set(tracestateHeader, trace.State)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctxutil.WithTrace(r.Context(), trace)))
+	})
+}
+
+func parseTraceparent(header string) (ctxutil.Trace, bool) {
+	parts := strings.Split(strings.TrimSpace(header), "-")
+	if len(parts) < 4 {
+		return ctxutil.Trace{}, false
+	}
+	version, traceID, parentID, flags := parts[0], parts[1], parts[2], parts[3]
+	if !isLowerHex(version, 2) || version == "ff" || (version == "00" && len(parts) != 4) {
+		return ctxutil.Trace{}, false
+	}
+	if !isLowerHex(traceID, 32) || isZeroHex(traceID) {
+		return ctxutil.Trace{}, false
+	}
+	if !isLowerHex(parentID, 16) || isZeroHex(parentID) {
+		return ctxutil.Trace{}, false
+	}
+	if !isLowerHex(flags, 2) {
+		return ctxutil.Trace{}, false
+	}
+	return ctxutil.Trace{TraceID: traceID, ParentSpanID: parentID, Flags: flags}, true
+}
+
+func formatTraceparent(trace ctxutil.Trace) string {
+	return "00-" + trace.TraceID + "-" + trace.SpanID + "-" + trace.Flags
+}
+
+func isLowerHex(s string, length int) bool {
+	if len(s) != length {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func isZeroHex(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,31 @@
+package httpserver
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+const actorHeader = "X-Actor-ID"
+
+func (h *handler) isTrustedCaller(r *http.Request) bool {
+	return ctxutil.CallerFrom(r.Context()).Trusted
+}
+
+func (h *handler) identifyCaller(r *http.Request) ctxutil.Caller {
+	if h.trustedToken == nil {
+		return ctxutil.Caller{}
+	}
+	trusted := h.trustedToken()
+	if trusted == "" {
+		return ctxutil.Caller{}
+	}
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(trusted)) != 1 {
+		return ctxutil.Caller{}
+	}
+	return ctxutil.Caller{Trusted: true, UserID: domain.NormalizeID(r.Header.Get(actorHeader))}
+}
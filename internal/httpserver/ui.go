@@ -0,0 +1,74 @@
+package httpserver
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+//go:embed ui/index.html
+var adminUI []byte
+
+func (h *handler) handleUI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", "default-src 'self'; script-src 'unsafe-inline'; style-src 'unsafe-inline'")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(adminUI)
+}
+
+func (h *handler) handleAdminOverview(w http.ResponseWriter, r *http.Request) {
+	if !h.isTrustedCaller(r) {
+		writeError(w, http.StatusForbidden, "FORBIDDEN", "admin overview is available only for trusted callers")
+		return
+	}
+
+	overview, err := h.admin.GetOverview(r.Context())
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	teams := make([]map[string]any, 0, len(overview.Teams))
+	for _, t := range overview.Teams {
+		teams = append(teams, map[string]any{
+			"team_name":      t.TeamName,
+			"members":        t.Members,
+			"active_members": t.ActiveMembers,
+		})
+	}
+
+	prs := make([]map[string]any, 0, len(overview.OpenPullRequests))
+	for _, pr := range overview.OpenPullRequests {
+		prs = append(prs, map[string]any{
+			"pull_request_id":    pr.ID,
+			"pull_request_name":  pr.Name,
+			"author_id":          pr.AuthorID,
+			"team_name":          pr.TeamName,
+			"assigned_reviewers": pr.Reviewers,
+			"createdAt":          formatTime(pr.CreatedAt),
+		})
+	}
+
+	reviewers := make([]map[string]any, 0, len(overview.Reviewers))
+	for _, rl := range overview.Reviewers {
+		reviewers = append(reviewers, map[string]any{
+			"user_id":      rl.UserID,
+			"username":     rl.Username,
+			"team_name":    rl.TeamName,
+			"is_active":    rl.IsActive,
+			"open_reviews": rl.OpenReviews,
+		})
+	}
+
+	var refreshedAt any
+	if overview.ReviewersRefreshedAt != nil {
+		refreshedAt = formatTime(*overview.ReviewersRefreshedAt)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"teams":                  teams,
+		"open_pull_requests":     prs,
+		"reviewers":              reviewers,
+		"reviewers_refreshed_at": refreshedAt,
+	})
+}
@@ -0,0 +1,40 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleUserPatch(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID     string   `json:"user_id"`
+		Version    *int64   `json:"version"`
+		UpdateMask []string `json:"update_mask"`
+		Username   string   `json:"username"`
+		IsActive   *bool    `json:"is_active"`
+		Seniority  string   `json:"seniority"`
+		Timezone   string   `json:"timezone"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	patch, err := domain.NewUserPatch(req.UserID, req.UpdateMask, req.Username, req.IsActive, req.Seniority, req.Timezone, req.Version)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	user, hours, err := h.users.PatchUser(r.Context(), patch)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"user":          mapUser(user),
+		"working_hours": mapWorkingHours(hours),
+	})
+}
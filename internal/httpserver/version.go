@@ -0,0 +1,17 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/buildinfo"
+)
+
+func (h *handler) handleVersion(w http.ResponseWriter, _ *http.Request) {
+	info := buildinfo.Get()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"version":    info.Version,
+		"commit":     info.Commit,
+		"build_time": info.BuildTime,
+		"go_version": info.GoVersion,
+	})
+}
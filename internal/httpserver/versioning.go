@@ -0,0 +1,96 @@
+package httpserver
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type apiVersion int
+
+const (
+	apiV1 apiVersion = 1
+	apiV2 apiVersion = 2
+)
+
+var vendorMediaType = regexp.MustCompile(`^application/vnd\.prservice\.v(\d+)\+json$`)
+
+var responseEncoders = map[apiVersion]func(payload any) any{
+	apiV1: func(payload any) any {
+		return payload
+	},
+	apiV2: func(payload any) any {
+		return map[string]any{
+			"data": payload,
+		}
+	},
+}
+
+func (v apiVersion) contentType() string {
+	if v == apiV1 {
+		return "application/json"
+	}
+	return fmt.Sprintf("application/vnd.prservice.v%d+json", v)
+}
+
+type versionedResponseWriter struct {
+	http.ResponseWriter
+	version apiVersion
+}
+
+func (w *versionedResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func negotiateVersion(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version, ok := acceptedVersion(r.Header.Get("Accept"))
+		if !ok {
+			writeError(w, http.StatusNotAcceptable, "NOT_ACCEPTABLE", "unsupported response version in Accept header")
+			return
+		}
+		w.Header().Add("Vary", "Accept")
+		next.ServeHTTP(&versionedResponseWriter{ResponseWriter: w, version: version}, r)
+	})
+}
+
+func acceptedVersion(accept string) (apiVersion, bool) {
+	if accept == "" {
+		return apiV1, true
+	}
+
+	requested := false
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		match := vendorMediaType.FindStringSubmatch(mediaType)
+		if match == nil {
+			continue
+		}
+		requested = true
+		n, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+		if _, ok := responseEncoders[apiVersion(n)]; ok {
+			return apiVersion(n), true
+		}
+	}
+	if requested {
+		return 0, false
+	}
+
+	return apiV1, true
+}
+
+func responseVersion(w http.ResponseWriter) apiVersion {
+	if vw, ok := w.(*versionedResponseWriter); ok {
+		return vw.version
+	}
+	return apiV1
+}
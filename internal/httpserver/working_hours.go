@@ -0,0 +1,79 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (h *handler) handleUserWorkingHoursGet(w http.ResponseWriter, r *http.Request) {
+	userID := strings.TrimSpace(r.URL.Query().Get("user_id"))
+	if userID == "" {
+		writeValidationError(w, errors.New("user_id query parameter is required"))
+		return
+	}
+
+	hours, err := h.users.GetWorkingHours(r.Context(), userID)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapWorkingHours(hours))
+}
+
+func (h *handler) handleUserWorkingHoursSet(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		UserID   string `json:"user_id"`
+		Timezone string `json:"timezone"`
+		Start    string `json:"start"`
+		End      string `json:"end"`
+	}
+	if err := decodeJSON(r.Context(), r.Body, &req); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if req.UserID == "" {
+		writeValidationError(w, errors.New("user_id is required"))
+		return
+	}
+
+	start, end := domain.DefaultWorkStart, domain.DefaultWorkEnd
+	for _, field := range []struct {
+		value string
+		dst   *time.Duration
+	}{{req.Start, &start}, {req.End, &end}} {
+		if field.value == "" {
+			continue
+		}
+		parsed, err := domain.ParseClock(field.value)
+		if err != nil {
+			writeValidationError(w, err)
+			return
+		}
+		*field.dst = parsed
+	}
+
+	hours, err := h.users.SetWorkingHours(r.Context(), req.UserID, req.Timezone, start, end)
+	if err != nil {
+		h.writeServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapWorkingHours(hours))
+}
+
+func mapWorkingHours(hours domain.WorkingHours) map[string]any {
+	resp := map[string]any{
+		"user_id": hours.UserID,
+	}
+	if hours.Known() {
+		resp["timezone"] = hours.Timezone
+		resp["start"] = domain.FormatClock(hours.Start)
+		resp["end"] = domain.FormatClock(hours.End)
+	}
+	return resp
+}
@@ -0,0 +1,170 @@
+package icalendar
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+const maxFeedBytes = 16 << 20
+
+type Feed struct {
+	url    func() string
+	client *http.Client
+}
+
+func NewFeed(url func() string, client *http.Client) *Feed {
+	return &Feed{url: url, client: client}
+}
+
+func (f *Feed) Events(ctx context.Context) ([]domain.CalendarEvent, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build calendar request: %w", err)
+	}
+	req.Header.Set("Accept", "text/calendar")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch calendar: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch calendar: unexpected status %s", resp.Status)
+	}
+
+	return Parse(io.LimitReader(resp.Body, maxFeedBytes))
+}
+
+func (f *Feed) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, f.url(), nil)
+	if err != nil {
+		return fmt.Errorf("build calendar request: %w", err)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("reach calendar: %w", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("reach calendar: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func Parse(r io.Reader) ([]domain.CalendarEvent, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []domain.CalendarEvent
+	var current *domain.CalendarEvent
+	for i, line := range lines {
+		name, params, value, ok := splitProperty(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			current = &domain.CalendarEvent{}
+		case name == "END" && value == "VEVENT" && current != nil:
+			events = append(events, *current)
+			current = nil
+		case current == nil:
+		case name == "UID":
+			current.UID = value
+		case name == "SUMMARY":
+			current.Summary = unescape(value)
+		case name == "ORGANIZER":
+			current.Organizer = strings.TrimPrefix(strings.ToLower(value), "mailto:")
+		case name == "STATUS":
+			current.Cancelled = strings.EqualFold(value, "CANCELLED")
+		case name == "DTSTART":
+			t, err := parseTime(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: DTSTART: %w", i+1, err)
+			}
+			current.StartsAt = t
+		case name == "DTEND":
+			t, err := parseTime(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: DTEND: %w", i+1, err)
+			}
+			current.EndsAt = t
+		}
+	}
+
+	for i := range events {
+		if events[i].EndsAt.IsZero() && !events[i].StartsAt.IsZero() {
+			events[i].EndsAt = events[i].StartsAt.Add(24 * time.Hour)
+		}
+	}
+	return events, nil
+}
+
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+func splitProperty(line string) (string, map[string]string, string, bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", nil, "", false
+	}
+	parts := strings.Split(head, ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return strings.ToUpper(parts[0]), params, value, true
+}
+
+func parseTime(value string, params map[string]string) (time.Time, error) {
+	if params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		return time.ParseInLocation("20060102", value, time.UTC)
+	}
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse("20060102T150405Z", value)
+	}
+	loc := time.UTC
+	if tzid := params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+func unescape(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,77 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Periodic struct {
+	name     string
+	interval time.Duration
+	fn       func(context.Context) error
+	logger   *zap.Logger
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+func NewPeriodic(name string, interval time.Duration, logger *zap.Logger, fn func(context.Context) error) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		fn:       fn,
+		logger:   logger,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+func (p *Periodic) Name() string {
+	return p.name
+}
+
+func (p *Periodic) Run() error {
+	defer close(p.doneCh)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-p.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stopCh:
+			return nil
+		case <-ticker.C:
+			start := time.Now()
+			if err := p.fn(ctx); err != nil && ctx.Err() == nil {
+				p.logger.Error("job failed", zap.String("job", p.name), zap.Error(err))
+				continue
+			}
+			p.logger.Debug("job finished", zap.String("job", p.name), zap.Duration("duration", time.Since(start)))
+		}
+	}
+}
+
+func (p *Periodic) Stop(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.stopCh) })
+
+	select {
+	case <-p.doneCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+var knownLoggers = []string{"httpserver", "service", "postgres", "postgres-read", "migrations", "jobs", "secrets"}
+
+type levelCore struct {
+	zapcore.Core
+	level     zapcore.Level
+	overrides map[string]zapcore.Level
+}
+
+func newLevelCore(core zapcore.Core, level zapcore.Level, overrides map[string]zapcore.Level) zapcore.Core {
+	if len(overrides) == 0 {
+		return core
+	}
+	return &levelCore{Core: core, level: level, overrides: overrides}
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), level: c.level, overrides: c.overrides}
+}
+
+func (c *levelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if ent.Level < c.levelFor(ent.LoggerName) {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
+
+func (c *levelCore) levelFor(loggerName string) zapcore.Level {
+	name, _, _ := strings.Cut(loggerName, ".")
+	if level, ok := c.overrides[name]; ok {
+		return level
+	}
+	return c.level
+}
@@ -1,19 +1,113 @@
 package logger
 
 import (
+	"fmt"
+	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
-func New(level string) (*zap.Logger, error) {
-	cfg := zap.NewProductionConfig()
-	cfg.Level = zap.NewAtomicLevelAt(parseLevel(level))
-	cfg.EncoderConfig.TimeKey = "ts"
-	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+const (
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+type Options struct {
+	Level              string
+	PIIMode            string
+	PIIKey             string
+	PIIKeys            []string
+	Format             string
+	SamplingInitial    int
+	SamplingThereafter int
+	LevelOverrides     string
+}
+
+func New(opts Options) (*zap.Logger, error) {
+	level := parseLevel(opts.Level)
+	overrides, err := parseLevelOverrides(opts.LevelOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, err := newEncoder(opts.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	minLevel := level
+	for _, l := range overrides {
+		minLevel = min(minLevel, l)
+	}
+
+	sink := zapcore.Lock(os.Stderr)
+	chatty, err := newScrubCore(zapcore.NewCore(encoder, sink, zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+		return l >= minLevel && l < zapcore.WarnLevel
+	})), opts.PIIMode, opts.PIIKey, opts.PIIKeys)
+	if err != nil {
+		return nil, err
+	}
+	if opts.SamplingInitial > 0 {
+		chatty = zapcore.NewSamplerWithOptions(chatty, time.Second, opts.SamplingInitial, opts.SamplingThereafter)
+	}
+	important, err := newScrubCore(zapcore.NewCore(encoder.Clone(), sink, zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+		return l >= minLevel && l >= zapcore.WarnLevel
+	})), opts.PIIMode, opts.PIIKey, opts.PIIKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	core := newLevelCore(zapcore.NewTee(chatty, important), level, overrides)
+
+	return zap.New(core,
+		zap.AddCaller(),
+		zap.AddStacktrace(zapcore.ErrorLevel),
+		zap.ErrorOutput(sink),
+	), nil
+}
+
+func newEncoder(format string) (zapcore.Encoder, error) {
+	cfg := zap.NewProductionEncoderConfig()
+	cfg.TimeKey = "ts"
+	cfg.EncodeTime = zapcore.ISO8601TimeEncoder
 
-	return cfg.Build()
+	switch strings.ToLower(format) {
+	case "", FormatJSON:
+		return zapcore.NewJSONEncoder(cfg), nil
+	case FormatConsole:
+		cfg.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		return zapcore.NewConsoleEncoder(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q, expected json or console", format)
+	}
+}
+
+func parseLevelOverrides(raw string) (map[string]zapcore.Level, error) {
+	overrides := make(map[string]zapcore.Level)
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid log level override %q, expected name=level", pair)
+		}
+		if !slices.Contains(knownLoggers, name) {
+			return nil, fmt.Errorf("unknown logger %q in log level override, expected one of %s", name, strings.Join(knownLoggers, ", "))
+		}
+		var level zapcore.Level
+		if err := level.UnmarshalText([]byte(strings.TrimSpace(value))); err != nil {
+			return nil, fmt.Errorf("invalid log level override %q: %w", pair, err)
+		}
+		overrides[name] = level
+	}
+	return overrides, nil
 }
 
 func parseLevel(level string) zapcore.Level {
@@ -0,0 +1,251 @@
+package logger
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	PIIModePlain    = "plain"
+	PIIModeHashed   = "hashed"
+	PIIModeRedacted = "redacted"
+)
+
+const redactedValue = "[REDACTED]"
+
+var defaultPIIKeys = []string{
+	"user_id",
+	"username",
+	"author_id",
+	"reviewer_id",
+	"old_user_id",
+	"old_reviewer_id",
+	"replaced_by",
+	"reviewers",
+}
+
+func ParsePIIMode(mode string) (string, error) {
+	switch m := strings.ToLower(strings.TrimSpace(mode)); m {
+	case "", PIIModePlain:
+		return PIIModePlain, nil
+	case PIIModeHashed, PIIModeRedacted:
+		return m, nil
+	default:
+		return "", fmt.Errorf("unknown PII mode %q, expected plain, hashed or redacted", mode)
+	}
+}
+
+// NewScrubber returns the function that masks a single identifier in the given
+// mode. Hashed mode needs a secret key: an unkeyed hash of a short, guessable ID
+// is reversed by hashing candidate IDs.
+func NewScrubber(mode, key string) (func(string) string, error) {
+	mode, err := ParsePIIMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	switch mode {
+	case PIIModeHashed:
+		if key == "" {
+			return nil, errors.New("hashed PII mode requires a key")
+		}
+		return func(v string) string { return HashPII(key, v) }, nil
+	case PIIModeRedacted:
+		return func(string) string { return redactedValue }, nil
+	default:
+		return func(v string) string { return v }, nil
+	}
+}
+
+// scrubCore masks the values of known PII keys, including strings nested in
+// arrays and zap.Object/zap.Inline values. Free-form values such as a panic
+// message or an error text are not parsed and are logged as is.
+type scrubCore struct {
+	zapcore.Core
+	keys  map[string]struct{}
+	scrub func(string) string
+}
+
+func newScrubCore(core zapcore.Core, mode, key string, extraKeys []string) (zapcore.Core, error) {
+	mode, err := ParsePIIMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	if mode == PIIModePlain {
+		return core, nil
+	}
+	scrub, err := NewScrubber(mode, key)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]struct{}, len(defaultPIIKeys)+len(extraKeys))
+	for _, key := range append(slices.Clone(defaultPIIKeys), extraKeys...) {
+		keys[key] = struct{}{}
+	}
+	return &scrubCore{Core: core, keys: keys, scrub: scrub}, nil
+}
+
+func (c *scrubCore) With(fields []zapcore.Field) zapcore.Core {
+	return &scrubCore{Core: c.Core.With(c.scrubFields(fields)), keys: c.keys, scrub: c.scrub}
+}
+
+func (c *scrubCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *scrubCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(ent, c.scrubFields(fields))
+}
+
+func (c *scrubCore) scrubFields(fields []zapcore.Field) []zapcore.Field {
+	scrubbed := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		scrubbed[i] = c.scrubField(f)
+	}
+	return scrubbed
+}
+
+func (c *scrubCore) isPII(key string) bool {
+	_, ok := c.keys[key]
+	return ok
+}
+
+func (c *scrubCore) scrubField(f zapcore.Field) zapcore.Field {
+	pii := c.isPII(f.Key)
+	switch f.Type {
+	case zapcore.ObjectMarshalerType, zapcore.InlineMarshalerType:
+		f.Interface = scrubObject{ObjectMarshaler: f.Interface.(zapcore.ObjectMarshaler), core: c, all: pii}
+	case zapcore.ArrayMarshalerType:
+		f.Interface = scrubArray{ArrayMarshaler: f.Interface.(zapcore.ArrayMarshaler), core: c, all: pii}
+	}
+	if !pii {
+		return f
+	}
+	switch f.Type {
+	case zapcore.StringType:
+		f.String = c.scrub(f.String)
+	case zapcore.ByteStringType:
+		f.Type, f.String, f.Interface = zapcore.StringType, c.scrub(string(f.Interface.([]byte))), nil
+	case zapcore.StringerType, zapcore.ReflectType:
+		f.Type, f.String, f.Interface = zapcore.StringType, c.scrub(fmt.Sprint(f.Interface)), nil
+	}
+	return f
+}
+
+// scrubObject and scrubArray mask nested strings stored under PII keys. When
+// all is set the whole value sits under a PII key and every string in it is
+// masked.
+type scrubObject struct {
+	zapcore.ObjectMarshaler
+	core *scrubCore
+	all  bool
+}
+
+func (o scrubObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return o.ObjectMarshaler.MarshalLogObject(scrubObjectEncoder{ObjectEncoder: enc, core: o.core, all: o.all})
+}
+
+type scrubObjectEncoder struct {
+	zapcore.ObjectEncoder
+	core *scrubCore
+	all  bool
+}
+
+func (e scrubObjectEncoder) pii(key string) bool {
+	return e.all || e.core.isPII(key)
+}
+
+func (e scrubObjectEncoder) AddString(key, v string) {
+	if e.pii(key) {
+		v = e.core.scrub(v)
+	}
+	e.ObjectEncoder.AddString(key, v)
+}
+
+func (e scrubObjectEncoder) AddByteString(key string, v []byte) {
+	if e.pii(key) {
+		e.ObjectEncoder.AddString(key, e.core.scrub(string(v)))
+		return
+	}
+	e.ObjectEncoder.AddByteString(key, v)
+}
+
+func (e scrubObjectEncoder) AddReflected(key string, v any) error {
+	if e.pii(key) {
+		e.ObjectEncoder.AddString(key, e.core.scrub(fmt.Sprint(v)))
+		return nil
+	}
+	return e.ObjectEncoder.AddReflected(key, v)
+}
+
+func (e scrubObjectEncoder) AddObject(key string, v zapcore.ObjectMarshaler) error {
+	return e.ObjectEncoder.AddObject(key, scrubObject{ObjectMarshaler: v, core: e.core, all: e.pii(key)})
+}
+
+func (e scrubObjectEncoder) AddArray(key string, v zapcore.ArrayMarshaler) error {
+	return e.ObjectEncoder.AddArray(key, scrubArray{ArrayMarshaler: v, core: e.core, all: e.pii(key)})
+}
+
+type scrubArray struct {
+	zapcore.ArrayMarshaler
+	core *scrubCore
+	all  bool
+}
+
+func (a scrubArray) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return a.ArrayMarshaler.MarshalLogArray(scrubArrayEncoder{ArrayEncoder: enc, core: a.core, all: a.all})
+}
+
+type scrubArrayEncoder struct {
+	zapcore.ArrayEncoder
+	core *scrubCore
+	all  bool
+}
+
+func (e scrubArrayEncoder) AppendString(v string) {
+	if e.all {
+		v = e.core.scrub(v)
+	}
+	e.ArrayEncoder.AppendString(v)
+}
+
+func (e scrubArrayEncoder) AppendByteString(v []byte) {
+	if e.all {
+		e.ArrayEncoder.AppendString(e.core.scrub(string(v)))
+		return
+	}
+	e.ArrayEncoder.AppendByteString(v)
+}
+
+func (e scrubArrayEncoder) AppendReflected(v any) error {
+	if e.all {
+		e.ArrayEncoder.AppendString(e.core.scrub(fmt.Sprint(v)))
+		return nil
+	}
+	return e.ArrayEncoder.AppendReflected(v)
+}
+
+func (e scrubArrayEncoder) AppendObject(v zapcore.ObjectMarshaler) error {
+	return e.ArrayEncoder.AppendObject(scrubObject{ObjectMarshaler: v, core: e.core, all: e.all})
+}
+
+func (e scrubArrayEncoder) AppendArray(v zapcore.ArrayMarshaler) error {
+	return e.ArrayEncoder.AppendArray(scrubArray{ArrayMarshaler: v, core: e.core, all: e.all})
+}
+
+// HashPII returns a keyed hash of value, stable for a given key so the same
+// user can still be followed across log lines.
+func HashPII(key, value string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(value))
+	return "hmac:" + hex.EncodeToString(mac.Sum(nil)[:8])
+}
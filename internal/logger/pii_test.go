@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type reviewLog struct {
+	ReviewerID string
+	Status     string
+}
+
+func (r reviewLog) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("reviewer_id", r.ReviewerID)
+	enc.AddString("status", r.Status)
+	return nil
+}
+
+func TestHashedScrubberNeedsKey(t *testing.T) {
+	if _, err := NewScrubber(PIIModeHashed, ""); err == nil {
+		t.Fatalf("hashed scrubber without a key was accepted")
+	}
+
+	a, err := NewScrubber(PIIModeHashed, "key-a")
+	if err != nil {
+		t.Fatalf("NewScrubber: %v", err)
+	}
+	b, err := NewScrubber(PIIModeHashed, "key-b")
+	if err != nil {
+		t.Fatalf("NewScrubber: %v", err)
+	}
+	if a("u1") != a("u1") {
+		t.Errorf("hash is not stable for one key")
+	}
+	if a("u1") == b("u1") {
+		t.Errorf("hash does not depend on the key")
+	}
+	if strings.Contains(a("u1"), "u1") {
+		t.Errorf("hash %q leaks the value", a("u1"))
+	}
+}
+
+func TestScrubCoreMasksNestedValues(t *testing.T) {
+	observed, logs := observer.New(zapcore.DebugLevel)
+	core, err := newScrubCore(observed, PIIModeRedacted, "", nil)
+	if err != nil {
+		t.Fatalf("newScrubCore: %v", err)
+	}
+
+	zap.New(core).Info("reviewed",
+		zap.String("user_id", "u1"),
+		zap.Object("review", reviewLog{ReviewerID: "u2", Status: "OPEN"}),
+		zap.Inline(reviewLog{ReviewerID: "u3", Status: "MERGED"}),
+		zap.Strings("reviewers", []string{"u4", "u5"}),
+	)
+
+	fields := logs.All()[0].ContextMap()
+	if fields["user_id"] != redactedValue {
+		t.Errorf("user_id = %v", fields["user_id"])
+	}
+	review := fields["review"].(map[string]any)
+	if review["reviewer_id"] != redactedValue || review["status"] != "OPEN" {
+		t.Errorf("review = %v", review)
+	}
+	if fields["reviewer_id"] != redactedValue || fields["status"] != "MERGED" {
+		t.Errorf("inlined fields = %v", fields)
+	}
+	for _, r := range fields["reviewers"].([]any) {
+		if r != redactedValue {
+			t.Errorf("reviewers = %v", fields["reviewers"])
+		}
+	}
+}
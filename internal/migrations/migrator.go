@@ -1,10 +1,17 @@
 package migrations
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
@@ -16,40 +23,257 @@ import (
 //go:embed sql/*.sql
 var files embed.FS
 
-func Run(ctx context.Context, databaseURL string, logger *zap.Logger) error {
+const (
+	lockName          = "pr-reviewer-migrations"
+	lockRetryInterval = 500 * time.Millisecond
+	contractMarker    = "contract_"
+)
+
+type Status struct {
+	Current  uint
+	Expected uint
+	Latest   uint
+	Dirty    bool
+}
+
+type migrationFile struct {
+	version  uint
+	contract bool
+}
+
+func (s Status) UpToDate() bool {
+	return !s.Dirty && s.Current == s.Expected
+}
+
+func Run(ctx context.Context, databaseURL string, lockTimeout time.Duration, contract bool, logger *zap.Logger) error {
+	available, err := listMigrations()
+	if err != nil {
+		return err
+	}
+
+	m, db, closeFn, err := open(ctx, databaseURL)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	unlock, err := acquireLock(ctx, db, lockTimeout, logger)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("schema version %d is dirty", current)
+	}
+
+	pending, blocked := uint(0), false
+	if !contract {
+		pending, blocked = firstContractAfter(available, current)
+	}
+	if blocked {
+		if expected := expectedVersion(available); pending < expected {
+			return fmt.Errorf("migration %d requires contract migration %d, run with MIGRATE_CONTRACT=true", expected, pending)
+		}
+		if pending-1 > current {
+			if err := m.Migrate(pending - 1); err != nil && err != migrate.ErrNoChange {
+				return fmt.Errorf("apply migrations: %w", err)
+			}
+		}
+		if logger != nil {
+			logger.Warn("contract migration pending, run with MIGRATE_CONTRACT=true once every replica runs the new release",
+				zap.Uint("schema_version", max(current, pending-1)),
+				zap.Uint("contract_version", pending),
+			)
+		}
+		return nil
+	}
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		return fmt.Errorf("apply migrations: %w", err)
+	}
+
+	if logger != nil {
+		logger.Info("migrations applied")
+	}
+
+	return nil
+}
+
+func GetStatus(ctx context.Context, databaseURL string) (Status, error) {
+	available, err := listMigrations()
+	if err != nil {
+		return Status{}, err
+	}
+
+	m, _, closeFn, err := open(ctx, databaseURL)
+	if err != nil {
+		return Status{}, err
+	}
+	defer closeFn()
+
+	current, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return Status{}, fmt.Errorf("read schema version: %w", err)
+	}
+
+	return Status{Current: current, Expected: expectedVersion(available), Latest: latestVersion(available), Dirty: dirty}, nil
+}
+
+func VerifyCompatible(ctx context.Context, databaseURL string) error {
+	status, err := GetStatus(ctx, databaseURL)
+	if err != nil {
+		return err
+	}
+	if status.Dirty {
+		return fmt.Errorf("schema version %d is dirty", status.Current)
+	}
+	if status.Current < status.Expected {
+		return fmt.Errorf("schema version %d is older than expected %d, run migrations first", status.Current, status.Expected)
+	}
+	return nil
+}
+
+func ExpectedVersion() (uint, error) {
+	available, err := listMigrations()
+	if err != nil {
+		return 0, err
+	}
+	return expectedVersion(available), nil
+}
+
+func listMigrations() ([]migrationFile, error) {
+	entries, err := fs.ReadDir(files, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("read embedded migrations: %w", err)
+	}
+
+	seen := make(map[uint]bool, len(entries))
+	var available []migrationFile
+	for _, entry := range entries {
+		prefix, name, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			continue
+		}
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil || seen[uint(version)] {
+			continue
+		}
+		seen[uint(version)] = true
+		available = append(available, migrationFile{version: uint(version), contract: strings.HasPrefix(name, contractMarker)})
+	}
+
+	slices.SortFunc(available, func(a, b migrationFile) int {
+		return cmp.Compare(a.version, b.version)
+	})
+	return available, nil
+}
+
+func expectedVersion(available []migrationFile) uint {
+	for i := len(available) - 1; i >= 0; i-- {
+		if !available[i].contract {
+			return available[i].version
+		}
+	}
+	return 0
+}
+
+func latestVersion(available []migrationFile) uint {
+	if len(available) == 0 {
+		return 0
+	}
+	return available[len(available)-1].version
+}
+
+func firstContractAfter(available []migrationFile, current uint) (uint, bool) {
+	for _, f := range available {
+		if f.version > current && f.contract {
+			return f.version, true
+		}
+	}
+	return 0, false
+}
+
+func open(ctx context.Context, databaseURL string) (*migrate.Migrate, *sql.DB, func(), error) {
 	db, err := sql.Open("pgx", databaseURL)
 	if err != nil {
-		return fmt.Errorf("open sql db: %w", err)
+		return nil, nil, nil, fmt.Errorf("open sql db: %w", err)
 	}
-	defer db.Close()
 
 	if err := db.PingContext(ctx); err != nil {
-		return fmt.Errorf("ping db: %w", err)
+		db.Close()
+		return nil, nil, nil, fmt.Errorf("ping db: %w", err)
 	}
 
 	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
-		return fmt.Errorf("init migrate driver: %w", err)
+		db.Close()
+		return nil, nil, nil, fmt.Errorf("init migrate driver: %w", err)
 	}
 
 	source, err := iofs.New(files, "sql")
 	if err != nil {
-		return fmt.Errorf("load migrations: %w", err)
+		db.Close()
+		return nil, nil, nil, fmt.Errorf("load migrations: %w", err)
 	}
 
 	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
 	if err != nil {
-		return fmt.Errorf("create migrate instance: %w", err)
+		db.Close()
+		return nil, nil, nil, fmt.Errorf("create migrate instance: %w", err)
 	}
-	defer m.Close()
 
-	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-		return fmt.Errorf("apply migrations: %w", err)
+	return m, db, func() {
+		m.Close()
+		db.Close()
+	}, nil
+}
+
+func acquireLock(ctx context.Context, db *sql.DB, timeout time.Duration, logger *zap.Logger) (func(), error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("open migration lock connection: %w", err)
 	}
 
-	if logger != nil {
-		logger.Info("migrations applied")
+	lockCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		lockCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 
-	return nil
+	started := time.Now()
+	for attempt := 0; ; attempt++ {
+		var locked bool
+		if err := conn.QueryRowContext(lockCtx, `SELECT pg_try_advisory_lock(hashtext($1))`, lockName).Scan(&locked); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("acquire migration lock: %w", err)
+		}
+		if locked {
+			if attempt > 0 && logger != nil {
+				logger.Info("migration lock acquired", zap.Duration("waited", time.Since(started)))
+			}
+			break
+		}
+
+		if attempt == 0 && logger != nil {
+			logger.Info("waiting for migration lock", zap.Duration("timeout", timeout))
+		}
+
+		select {
+		case <-lockCtx.Done():
+			conn.Close()
+			return nil, fmt.Errorf("wait for migration lock: %w", lockCtx.Err())
+		case <-time.After(lockRetryInterval):
+		}
+	}
+
+	return func() {
+		_, _ = conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, lockName)
+		conn.Close()
+	}, nil
 }
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_assignment_shadow_log_team_created;
+DROP TABLE IF EXISTS assignment_shadow_log;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS assignment_shadow_log (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    team_id BIGINT NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    primary_reviewers TEXT[] NOT NULL,
+    shadow_reviewers TEXT[] NOT NULL,
+    diverged BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_shadow_log_team_created ON assignment_shadow_log (team_id, created_at DESC);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS pr_checklist_checks;
+DROP TABLE IF EXISTS team_checklist_items;
+ALTER TABLE teams DROP COLUMN IF EXISTS checklist_required;
+
+COMMIT;
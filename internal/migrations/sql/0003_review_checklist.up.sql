@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS checklist_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS team_checklist_items (
+    item_id BIGSERIAL PRIMARY KEY,
+    team_id BIGINT NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    position INT NOT NULL,
+    UNIQUE (team_id, title)
+);
+
+CREATE TABLE IF NOT EXISTS pr_checklist_checks (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    item_id BIGINT NOT NULL REFERENCES team_checklist_items(item_id) ON DELETE CASCADE,
+    checked_by TEXT NOT NULL REFERENCES users(user_id),
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pull_request_id, item_id)
+);
+
+COMMIT;
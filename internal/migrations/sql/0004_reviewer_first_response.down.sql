@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS first_response_at;
+
+COMMIT;
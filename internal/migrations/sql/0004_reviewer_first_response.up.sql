@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS first_response_at TIMESTAMPTZ;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_pr_reviewers_snoozed_until;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS snooze_used_seconds;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS snoozed_until;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS snoozed_until TIMESTAMPTZ;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS snooze_used_seconds BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_snoozed_until ON pr_reviewers (snoozed_until) WHERE snoozed_until IS NOT NULL;
+
+COMMIT;
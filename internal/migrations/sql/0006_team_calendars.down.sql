@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS review_due_at;
+DROP TABLE IF EXISTS team_calendars;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS team_calendars (
+    team_id BIGINT PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    timezone TEXT NOT NULL,
+    work_start_minutes INT NOT NULL,
+    work_end_minutes INT NOT NULL,
+    workdays INT[] NOT NULL,
+    holidays DATE[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS review_due_at TIMESTAMPTZ;
+
+COMMIT;
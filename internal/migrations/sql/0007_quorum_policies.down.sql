@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS team_quorum_rules;
+ALTER TABLE users DROP COLUMN IF EXISTS seniority;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS seniority TEXT NOT NULL DEFAULT 'middle';
+
+CREATE TABLE IF NOT EXISTS team_quorum_rules (
+    team_id BIGINT NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    seniority TEXT NOT NULL,
+    min_reviewers INT NOT NULL CHECK (min_reviewers > 0),
+    PRIMARY KEY (team_id, seniority)
+);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS pr_links (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    blocked_by_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pull_request_id, blocked_by_id),
+    CHECK (pull_request_id <> blocked_by_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_links_blocked_by_id ON pr_links (blocked_by_id);
+
+COMMIT;
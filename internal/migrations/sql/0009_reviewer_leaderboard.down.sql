@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS reassigned;
+ALTER TABLE users DROP COLUMN IF EXISTS leaderboard_opt_out;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS leaderboard_opt_out BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS reassigned BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMIT;
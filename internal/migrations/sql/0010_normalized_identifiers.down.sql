@@ -0,0 +1,19 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_teams_team_name_lower;
+DROP TABLE IF EXISTS identifier_renames;
+
+ALTER TABLE team_memberships
+    DROP CONSTRAINT IF EXISTS team_memberships_user_id_fkey,
+    ADD CONSTRAINT team_memberships_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE;
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS pull_requests_author_id_fkey,
+    ADD CONSTRAINT pull_requests_author_id_fkey FOREIGN KEY (author_id) REFERENCES users(user_id);
+ALTER TABLE pr_reviewers
+    DROP CONSTRAINT IF EXISTS pr_reviewers_reviewer_id_fkey,
+    ADD CONSTRAINT pr_reviewers_reviewer_id_fkey FOREIGN KEY (reviewer_id) REFERENCES users(user_id);
+ALTER TABLE pr_checklist_checks
+    DROP CONSTRAINT IF EXISTS pr_checklist_checks_checked_by_fkey,
+    ADD CONSTRAINT pr_checklist_checks_checked_by_fkey FOREIGN KEY (checked_by) REFERENCES users(user_id);
+
+COMMIT;
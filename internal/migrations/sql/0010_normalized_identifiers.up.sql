@@ -0,0 +1,72 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS identifier_renames (
+    rename_id BIGSERIAL PRIMARY KEY,
+    entity TEXT NOT NULL CHECK (entity IN ('team', 'user')),
+    old_value TEXT NOT NULL,
+    new_value TEXT NOT NULL,
+    reason TEXT NOT NULL CHECK (reason IN ('normalized', 'duplicate')),
+    renamed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO identifier_renames (entity, old_value, new_value, reason)
+SELECT 'team',
+       team_name,
+       CASE WHEN rn > 1 THEN normalized || '-' || team_id ELSE normalized END,
+       CASE WHEN rn > 1 THEN 'duplicate' ELSE 'normalized' END
+FROM (
+    SELECT team_id,
+           team_name,
+           normalize(btrim(team_name), NFC) AS normalized,
+           row_number() OVER (
+               PARTITION BY lower(normalize(btrim(team_name), NFC))
+               ORDER BY created_at, team_id
+           ) AS rn
+    FROM teams
+) ranked
+WHERE rn > 1 OR team_name <> normalized;
+
+UPDATE teams t
+SET team_name = r.new_value
+FROM identifier_renames r
+WHERE r.entity = 'team'
+  AND r.old_value = t.team_name;
+
+ALTER TABLE team_memberships
+    DROP CONSTRAINT IF EXISTS team_memberships_user_id_fkey,
+    ADD CONSTRAINT team_memberships_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS pull_requests_author_id_fkey,
+    ADD CONSTRAINT pull_requests_author_id_fkey FOREIGN KEY (author_id) REFERENCES users(user_id) ON UPDATE CASCADE;
+ALTER TABLE pr_reviewers
+    DROP CONSTRAINT IF EXISTS pr_reviewers_reviewer_id_fkey,
+    ADD CONSTRAINT pr_reviewers_reviewer_id_fkey FOREIGN KEY (reviewer_id) REFERENCES users(user_id) ON UPDATE CASCADE;
+ALTER TABLE pr_checklist_checks
+    DROP CONSTRAINT IF EXISTS pr_checklist_checks_checked_by_fkey,
+    ADD CONSTRAINT pr_checklist_checks_checked_by_fkey FOREIGN KEY (checked_by) REFERENCES users(user_id) ON UPDATE CASCADE;
+
+INSERT INTO identifier_renames (entity, old_value, new_value, reason)
+SELECT 'user',
+       user_id,
+       CASE WHEN rn > 1 THEN normalized || '~' || left(md5(user_id), 8) ELSE normalized END,
+       CASE WHEN rn > 1 THEN 'duplicate' ELSE 'normalized' END
+FROM (
+    SELECT user_id,
+           normalize(btrim(user_id), NFC) AS normalized,
+           row_number() OVER (
+               PARTITION BY normalize(btrim(user_id), NFC)
+               ORDER BY user_id = normalize(btrim(user_id), NFC) DESC, created_at, user_id
+           ) AS rn
+    FROM users
+) ranked
+WHERE rn > 1 OR user_id <> normalized;
+
+UPDATE users u
+SET user_id = r.new_value
+FROM identifier_renames r
+WHERE r.entity = 'user'
+  AND r.old_value = u.user_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_teams_team_name_lower ON teams (lower(team_name));
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_pull_requests_open_name_unique;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS enforce_unique_name;
+ALTER TABLE teams DROP COLUMN IF EXISTS unique_open_pr_names;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS unique_open_pr_names BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS enforce_unique_name BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pull_requests_open_name_unique
+    ON pull_requests (author_id, pull_request_name)
+    WHERE enforce_unique_name AND status_id = 1;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS merged_by TEXT;
+
+COMMIT;
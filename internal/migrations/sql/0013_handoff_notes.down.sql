@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS handoff_note;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS handoff_from;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS handoff_from TEXT;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS handoff_note TEXT;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS ramp_up_days;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS ramp_up_days INT NOT NULL DEFAULT 0;
+
+COMMIT;
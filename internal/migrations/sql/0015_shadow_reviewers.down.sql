@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS mentoring_shadows;
+DELETE FROM pr_reviewers WHERE kind = 'shadow';
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS kind;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'regular'
+    CHECK (kind IN ('regular', 'shadow'));
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS mentoring_shadows BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DELETE FROM pull_requests WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_pull_requests_open_name_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pull_requests_open_name_unique
+    ON pull_requests (author_id, pull_request_name)
+    WHERE enforce_unique_name AND status_id = 1;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS deleted_at;
+
+COMMIT;
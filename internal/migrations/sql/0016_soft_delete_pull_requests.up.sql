@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS deleted_by TEXT;
+
+DROP INDEX IF EXISTS idx_pull_requests_open_name_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pull_requests_open_name_unique
+    ON pull_requests (author_id, pull_request_name)
+    WHERE enforce_unique_name AND status_id = 1 AND deleted_at IS NULL;
+
+COMMIT;
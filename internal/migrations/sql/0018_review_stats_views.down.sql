@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP MATERIALIZED VIEW IF EXISTS mv_team_weekly_throughput;
+DROP MATERIALIZED VIEW IF EXISTS mv_user_open_reviews;
+
+COMMIT;
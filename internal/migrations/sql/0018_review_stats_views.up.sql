@@ -0,0 +1,36 @@
+BEGIN;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS mv_user_open_reviews AS
+SELECT u.user_id,
+       COUNT(pr.pull_request_id) AS open_reviews,
+       COUNT(pr.pull_request_id) FILTER (WHERE rr.first_response_at IS NULL) AS pending_reviews
+FROM users u
+LEFT JOIN (
+    pr_reviewers rr
+    JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+        AND pr.status_id = 1 AND pr.deleted_at IS NULL
+) ON rr.reviewer_id = u.user_id AND rr.kind = 'regular'
+GROUP BY u.user_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mv_user_open_reviews_user_id ON mv_user_open_reviews (user_id);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS mv_team_weekly_throughput AS
+SELECT tm.team_id,
+       e.week_start,
+       COUNT(*) FILTER (WHERE e.kind = 'created') AS created,
+       COUNT(*) FILTER (WHERE e.kind = 'merged') AS merged
+FROM (
+    SELECT author_id, date_trunc('week', created_at AT TIME ZONE 'UTC') AS week_start, 'created' AS kind
+    FROM pull_requests
+    WHERE deleted_at IS NULL
+    UNION ALL
+    SELECT author_id, date_trunc('week', merged_at AT TIME ZONE 'UTC'), 'merged'
+    FROM pull_requests
+    WHERE deleted_at IS NULL AND merged_at IS NOT NULL
+) e
+JOIN team_memberships tm ON tm.user_id = e.author_id
+GROUP BY tm.team_id, e.week_start;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mv_team_weekly_throughput_team_week ON mv_team_weekly_throughput (team_id, week_start);
+
+COMMIT;
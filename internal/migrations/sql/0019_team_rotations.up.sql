@@ -0,0 +1,10 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS team_rotations (
+    team_id BIGINT PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    members TEXT[] NOT NULL,
+    starts_on DATE NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS reviewer_exclusions (
+    user_a TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    user_b TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_a, user_b),
+    CONSTRAINT reviewer_exclusions_ordered CHECK (user_a < user_b)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_exclusions_user_b ON reviewer_exclusions (user_b);
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS pr_aging_snapshots (
+    team_id BIGINT NOT NULL REFERENCES teams(team_id) ON DELETE CASCADE,
+    snapshot_date DATE NOT NULL,
+    under_1d INTEGER NOT NULL,
+    from_1d_to_3d INTEGER NOT NULL,
+    from_3d_to_7d INTEGER NOT NULL,
+    over_7d INTEGER NOT NULL,
+    taken_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (team_id, snapshot_date)
+);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS approvals_reset_at TIMESTAMPTZ;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS title;
+
+COMMIT;
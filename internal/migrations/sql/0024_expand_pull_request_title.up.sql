@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS title TEXT;
+
+COMMIT;
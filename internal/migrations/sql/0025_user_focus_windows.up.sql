@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS user_focus_windows (
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    timezone TEXT NOT NULL,
+    start_minutes INTEGER NOT NULL,
+    end_minutes INTEGER NOT NULL,
+    PRIMARY KEY (user_id, start_minutes),
+    CONSTRAINT user_focus_windows_range CHECK (start_minutes >= 0 AND start_minutes < end_minutes AND end_minutes <= 1440)
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_pr_reviewers_assigned_at;
+DROP TABLE IF EXISTS history_exports;
+
+COMMIT;
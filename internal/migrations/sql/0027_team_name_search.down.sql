@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_teams_team_name_trgm;
+
+COMMIT;
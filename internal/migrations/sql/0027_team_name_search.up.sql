@@ -0,0 +1,7 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_teams_team_name_trgm ON teams USING gin (lower(team_name) gin_trgm_ops);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS pr_reviewer_conflicts;
+
+COMMIT;
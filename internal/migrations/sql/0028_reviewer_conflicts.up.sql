@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS pr_reviewer_conflicts (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    reviewer_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    category TEXT NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    replaced_by TEXT NOT NULL,
+    declared_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (pull_request_id, reviewer_id),
+    CONSTRAINT pr_reviewer_conflicts_category CHECK (
+        category IN ('personal_relationship', 'financial_interest', 'reporting_line', 'prior_involvement', 'other')
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reviewer_conflicts_declared_at ON pr_reviewer_conflicts (declared_at);
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS policy_overrides (
+    scope TEXT NOT NULL,
+    team_id BIGINT REFERENCES teams(team_id) ON DELETE CASCADE,
+    pull_request_id TEXT REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    value TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT policy_overrides_scope CHECK (
+        (scope = 'org' AND team_id IS NULL AND pull_request_id IS NULL)
+        OR (scope = 'team' AND team_id IS NOT NULL AND pull_request_id IS NULL)
+        OR (scope = 'pull_request' AND team_id IS NULL AND pull_request_id IS NOT NULL)
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_policy_overrides_org ON policy_overrides (key) WHERE scope = 'org';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_policy_overrides_team ON policy_overrides (team_id, key) WHERE scope = 'team';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_policy_overrides_pull_request ON policy_overrides (pull_request_id, key) WHERE scope = 'pull_request';
+
+COMMIT;
@@ -0,0 +1,51 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS pr_reviewers_record_change ON pr_reviewers;
+DROP TRIGGER IF EXISTS pull_requests_record_change ON pull_requests;
+DROP TRIGGER IF EXISTS team_memberships_record_change ON team_memberships;
+DROP TRIGGER IF EXISTS users_record_change ON users;
+DROP TRIGGER IF EXISTS teams_record_change ON teams;
+
+DROP FUNCTION IF EXISTS record_pull_request_change();
+DROP FUNCTION IF EXISTS record_membership_change();
+DROP FUNCTION IF EXISTS record_user_change();
+DROP FUNCTION IF EXISTS record_team_change();
+
+DROP TABLE IF EXISTS sync_watermark;
+DROP TABLE IF EXISTS sync_changes;
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'teams', 'users', 'team_memberships', 'pull_request_statuses', 'pull_requests', 'pr_reviewers',
+        'assignment_shadow_log', 'team_checklist_items', 'pr_checklist_checks', 'team_calendars',
+        'team_quorum_rules', 'pr_links', 'announcements', 'team_rotations', 'user_absences',
+        'reviewer_exclusions', 'pr_aging_snapshots', 'user_focus_windows', 'history_exports',
+        'pr_reviewer_conflicts', 'policy_overrides'
+    ] LOOP
+        EXECUTE format('DROP TRIGGER IF EXISTS %I ON %I', t || '_set_updated_at', t);
+    END LOOP;
+END $$;
+
+ALTER TABLE team_memberships DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE pull_request_statuses DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE assignment_shadow_log DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE team_checklist_items DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE pr_checklist_checks DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE team_quorum_rules DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE pr_links DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE announcements DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE reviewer_exclusions DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE pr_aging_snapshots DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE user_focus_windows DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE history_exports DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE pr_reviewer_conflicts DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE teams DROP COLUMN IF EXISTS updated_at;
+
+DROP FUNCTION IF EXISTS set_updated_at();
+
+COMMIT;
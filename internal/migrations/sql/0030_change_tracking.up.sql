@@ -0,0 +1,121 @@
+BEGIN;
+
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'teams', 'users', 'team_memberships', 'pull_request_statuses', 'pull_requests', 'pr_reviewers',
+        'assignment_shadow_log', 'team_checklist_items', 'pr_checklist_checks', 'team_calendars',
+        'team_quorum_rules', 'pr_links', 'announcements', 'team_rotations', 'user_absences',
+        'reviewer_exclusions', 'pr_aging_snapshots', 'user_focus_windows', 'history_exports',
+        'pr_reviewer_conflicts', 'policy_overrides'
+    ] LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()', t);
+        EXECUTE format('DROP TRIGGER IF EXISTS %I ON %I', t || '_set_updated_at', t);
+        EXECUTE format('CREATE TRIGGER %I BEFORE UPDATE ON %I FOR EACH ROW EXECUTE FUNCTION set_updated_at()', t || '_set_updated_at', t);
+    END LOOP;
+END $$;
+
+CREATE TABLE IF NOT EXISTS sync_changes (
+    seq BIGSERIAL PRIMARY KEY,
+    entity TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()
+);
+
+CREATE INDEX IF NOT EXISTS idx_sync_changes_changed_at ON sync_changes (changed_at);
+CREATE INDEX IF NOT EXISTS idx_sync_changes_entity ON sync_changes (entity, entity_id, seq);
+
+CREATE TABLE IF NOT EXISTS sync_watermark (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    purged_through BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO sync_watermark (id) VALUES (TRUE) ON CONFLICT (id) DO NOTHING;
+
+CREATE OR REPLACE FUNCTION record_team_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO sync_changes (entity, entity_id) VALUES ('team', OLD.team_name);
+    ELSE
+        INSERT INTO sync_changes (entity, entity_id) VALUES ('team', NEW.team_name);
+        IF TG_OP = 'UPDATE' AND OLD.team_name <> NEW.team_name THEN
+            INSERT INTO sync_changes (entity, entity_id) VALUES ('team', OLD.team_name);
+        END IF;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION record_user_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO sync_changes (entity, entity_id) VALUES ('user', OLD.user_id);
+    ELSE
+        INSERT INTO sync_changes (entity, entity_id) VALUES ('user', NEW.user_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION record_membership_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        INSERT INTO sync_changes (entity, entity_id) SELECT 'team', team_name FROM teams WHERE team_id = OLD.team_id;
+        INSERT INTO sync_changes (entity, entity_id) VALUES ('user', OLD.user_id);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO sync_changes (entity, entity_id) SELECT 'team', team_name FROM teams WHERE team_id = NEW.team_id;
+        INSERT INTO sync_changes (entity, entity_id) VALUES ('user', NEW.user_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION record_pull_request_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO sync_changes (entity, entity_id) VALUES ('pull_request', OLD.pull_request_id);
+    ELSE
+        INSERT INTO sync_changes (entity, entity_id) VALUES ('pull_request', NEW.pull_request_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS teams_record_change ON teams;
+CREATE TRIGGER teams_record_change AFTER INSERT OR UPDATE OR DELETE ON teams
+    FOR EACH ROW EXECUTE FUNCTION record_team_change();
+
+DROP TRIGGER IF EXISTS users_record_change ON users;
+CREATE TRIGGER users_record_change AFTER INSERT OR UPDATE OR DELETE ON users
+    FOR EACH ROW EXECUTE FUNCTION record_user_change();
+
+DROP TRIGGER IF EXISTS team_memberships_record_change ON team_memberships;
+CREATE TRIGGER team_memberships_record_change AFTER INSERT OR UPDATE OR DELETE ON team_memberships
+    FOR EACH ROW EXECUTE FUNCTION record_membership_change();
+
+DROP TRIGGER IF EXISTS pull_requests_record_change ON pull_requests;
+CREATE TRIGGER pull_requests_record_change AFTER INSERT OR UPDATE OR DELETE ON pull_requests
+    FOR EACH ROW EXECUTE FUNCTION record_pull_request_change();
+
+DROP TRIGGER IF EXISTS pr_reviewers_record_change ON pr_reviewers;
+CREATE TRIGGER pr_reviewers_record_change AFTER INSERT OR UPDATE OR DELETE ON pr_reviewers
+    FOR EACH ROW EXECUTE FUNCTION record_pull_request_change();
+
+INSERT INTO sync_changes (entity, entity_id)
+SELECT 'team', team_name FROM teams
+UNION ALL
+SELECT 'user', user_id FROM users
+UNION ALL
+SELECT 'pull_request', pull_request_id FROM pull_requests;
+
+COMMIT;
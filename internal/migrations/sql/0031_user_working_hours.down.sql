@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_working_hours_check;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS work_end_minutes,
+    DROP COLUMN IF EXISTS work_start_minutes,
+    DROP COLUMN IF EXISTS timezone;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS timezone TEXT,
+    ADD COLUMN IF NOT EXISTS work_start_minutes SMALLINT,
+    ADD COLUMN IF NOT EXISTS work_end_minutes SMALLINT;
+
+ALTER TABLE users
+    ADD CONSTRAINT users_working_hours_check CHECK (
+        (timezone IS NULL AND work_start_minutes IS NULL AND work_end_minutes IS NULL)
+        OR (timezone IS NOT NULL AND 0 <= work_start_minutes AND work_start_minutes < work_end_minutes AND work_end_minutes <= 1440)
+    );
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+-- The composite primary key is part of the original schema (0001); there is nothing to revert.
+
+COMMIT;
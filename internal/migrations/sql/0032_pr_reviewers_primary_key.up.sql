@@ -0,0 +1,36 @@
+BEGIN;
+
+DO $$
+DECLARE
+    pk_name TEXT;
+    pk_columns TEXT[];
+BEGIN
+    SELECT c.conname, ARRAY(
+               SELECT a.attname::TEXT
+               FROM unnest(c.conkey) WITH ORDINALITY AS k(attnum, ord)
+               JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = k.attnum
+               ORDER BY k.ord
+           )
+    INTO pk_name, pk_columns
+    FROM pg_constraint c
+    WHERE c.conrelid = 'pr_reviewers'::regclass AND c.contype = 'p';
+
+    IF pk_columns IS NOT DISTINCT FROM ARRAY['pull_request_id', 'reviewer_id'] THEN
+        RETURN;
+    END IF;
+
+    IF pk_name IS NOT NULL THEN
+        EXECUTE format('ALTER TABLE pr_reviewers DROP CONSTRAINT %I', pk_name);
+    END IF;
+
+    DELETE FROM pr_reviewers r
+    USING pr_reviewers keep
+    WHERE r.pull_request_id = keep.pull_request_id
+      AND r.reviewer_id = keep.reviewer_id
+      AND (keep.kind, keep.assigned_at, keep.ctid) < (r.kind, r.assigned_at, r.ctid);
+
+    ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_pkey PRIMARY KEY (pull_request_id, reviewer_id);
+END
+$$;
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS reviewer_declines (
+    decline_id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    reviewer_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    team_id BIGINT REFERENCES teams(team_id) ON DELETE SET NULL,
+    reason TEXT,
+    note TEXT NOT NULL DEFAULT '',
+    replaced_by TEXT NOT NULL,
+    declined_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT reviewer_declines_reason CHECK (
+        reason IN ('overloaded', 'on_leave', 'conflict', 'lacks_context', 'other')
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_declines_team_declined_at ON reviewer_declines (team_id, declined_at);
+CREATE INDEX IF NOT EXISTS idx_reviewer_declines_pull_request_id ON reviewer_declines (pull_request_id);
+
+DROP TRIGGER IF EXISTS reviewer_declines_set_updated_at ON reviewer_declines;
+CREATE TRIGGER reviewer_declines_set_updated_at BEFORE UPDATE ON reviewer_declines
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
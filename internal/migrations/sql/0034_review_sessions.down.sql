@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS review_session_items;
+DROP TABLE IF EXISTS review_sessions;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS lines_changed;
+
+COMMIT;
@@ -0,0 +1,38 @@
+BEGIN;
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS lines_changed INT CHECK (lines_changed >= 0);
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS review_sessions (
+    session_id TEXT PRIMARY KEY,
+    reviewer_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'suggested',
+    line_budget INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT review_sessions_status CHECK (status IN ('suggested', 'accepted', 'completed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_sessions_reviewer_status ON review_sessions (reviewer_id, status);
+
+CREATE TABLE IF NOT EXISTS review_session_items (
+    session_id TEXT NOT NULL REFERENCES review_sessions(session_id) ON DELETE CASCADE,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    estimated_lines INT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (session_id, pull_request_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_session_items_pull_request_id ON review_session_items (pull_request_id);
+
+DROP TRIGGER IF EXISTS review_sessions_set_updated_at ON review_sessions;
+CREATE TRIGGER review_sessions_set_updated_at BEFORE UPDATE ON review_sessions
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+DROP TRIGGER IF EXISTS review_session_items_set_updated_at ON review_session_items;
+CREATE TRIGGER review_session_items_set_updated_at BEFORE UPDATE ON review_session_items
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
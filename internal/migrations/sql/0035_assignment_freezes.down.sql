@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS assignment_freezes;
+DROP INDEX IF EXISTS idx_pull_requests_assignment_deferred;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS assignment_deferred_at;
+
+COMMIT;
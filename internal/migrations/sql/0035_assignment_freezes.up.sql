@@ -0,0 +1,26 @@
+BEGIN;
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS assignment_deferred_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_assignment_deferred
+    ON pull_requests (assignment_deferred_at)
+    WHERE assignment_deferred_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS assignment_freezes (
+    freeze_id BIGSERIAL PRIMARY KEY,
+    reason TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    lifted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT assignment_freezes_window CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_freezes_ends_at ON assignment_freezes (ends_at);
+
+DROP TRIGGER IF EXISTS assignment_freezes_set_updated_at ON assignment_freezes;
+CREATE TRIGGER assignment_freezes_set_updated_at BEFORE UPDATE ON assignment_freezes
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
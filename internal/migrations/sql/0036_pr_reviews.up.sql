@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS pr_reviews (
+    pull_request_id TEXT NOT NULL,
+    reviewer_id TEXT NOT NULL,
+    approved_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pull_request_id, reviewer_id),
+    FOREIGN KEY (pull_request_id, reviewer_id)
+        REFERENCES pr_reviewers (pull_request_id, reviewer_id) ON DELETE CASCADE
+);
+
+DROP TRIGGER IF EXISTS pr_reviews_set_updated_at ON pr_reviews;
+CREATE TRIGGER pr_reviews_set_updated_at BEFORE UPDATE ON pr_reviews
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS users_bump_version ON users;
+DROP FUNCTION IF EXISTS bump_version();
+ALTER TABLE users DROP COLUMN IF EXISTS version;
+
+COMMIT;
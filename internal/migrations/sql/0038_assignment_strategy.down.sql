@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS team_assignment_cursors;
+ALTER TABLE teams DROP COLUMN IF EXISTS assignment_strategy;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS assignment_strategy TEXT
+    CONSTRAINT teams_assignment_strategy_check CHECK (assignment_strategy IN ('random', 'round_robin'));
+
+CREATE TABLE IF NOT EXISTS team_assignment_cursors (
+    team_id BIGINT PRIMARY KEY REFERENCES teams(team_id) ON DELETE CASCADE,
+    last_user_id TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+DROP TRIGGER IF EXISTS team_assignment_cursors_set_updated_at ON team_assignment_cursors;
+CREATE TRIGGER team_assignment_cursors_set_updated_at BEFORE UPDATE ON team_assignment_cursors
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS replica_roles (
+    region TEXT PRIMARY KEY,
+    role TEXT NOT NULL CONSTRAINT replica_roles_role_check CHECK (role IN ('primary', 'standby')),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+DROP TRIGGER IF EXISTS replica_roles_set_updated_at ON replica_roles;
+CREATE TRIGGER replica_roles_set_updated_at BEFORE UPDATE ON replica_roles
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
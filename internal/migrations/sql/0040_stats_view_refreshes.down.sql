@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS stats_view_refreshes;
+
+COMMIT;
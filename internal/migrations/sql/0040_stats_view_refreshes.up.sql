@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS stats_view_refreshes (
+    view_name TEXT PRIMARY KEY,
+    refreshed_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+DROP TRIGGER IF EXISTS stats_view_refreshes_set_updated_at ON stats_view_refreshes;
+CREATE TRIGGER stats_view_refreshes_set_updated_at BEFORE UPDATE ON stats_view_refreshes
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
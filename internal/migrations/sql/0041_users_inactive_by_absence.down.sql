@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS users_clear_inactive_by_absence ON users;
+DROP FUNCTION IF EXISTS clear_inactive_by_absence();
+ALTER TABLE users DROP COLUMN IF EXISTS inactive_by_absence;
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS inactive_by_absence BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE users u
+SET inactive_by_absence = TRUE
+WHERE NOT u.is_active
+  AND EXISTS (
+      SELECT 1 FROM user_absences a
+      WHERE a.user_id = u.user_id AND a.deactivated AND a.restored_at IS NULL
+  );
+
+CREATE OR REPLACE FUNCTION clear_inactive_by_absence() RETURNS trigger AS $$
+BEGIN
+    IF NEW.is_active IS DISTINCT FROM OLD.is_active
+       AND NEW.inactive_by_absence IS NOT DISTINCT FROM OLD.inactive_by_absence THEN
+        NEW.inactive_by_absence = FALSE;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS users_clear_inactive_by_absence ON users;
+CREATE TRIGGER users_clear_inactive_by_absence BEFORE UPDATE ON users
+    FOR EACH ROW EXECUTE FUNCTION clear_inactive_by_absence();
+
+COMMIT;
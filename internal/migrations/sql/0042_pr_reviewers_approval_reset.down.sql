@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS approval_reset_at;
+
+COMMIT;
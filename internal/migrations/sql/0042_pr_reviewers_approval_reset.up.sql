@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS approval_reset_at TIMESTAMPTZ;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE sync_watermark DROP COLUMN IF EXISTS purged_through_txid;
+
+DROP INDEX IF EXISTS idx_sync_changes_txid_seq;
+
+ALTER TABLE sync_changes DROP COLUMN IF EXISTS txid;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE sync_changes ADD COLUMN IF NOT EXISTS txid XID8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE INDEX IF NOT EXISTS idx_sync_changes_txid_seq ON sync_changes (txid, seq);
+
+ALTER TABLE sync_watermark ADD COLUMN IF NOT EXISTS purged_through_txid XID8 NOT NULL DEFAULT '0';
+
+COMMIT;
@@ -0,0 +1,49 @@
+package replica
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+type Role string
+
+const (
+	RolePrimary Role = "primary"
+	RoleStandby Role = "standby"
+)
+
+func ParseRole(raw string) (Role, error) {
+	switch role := Role(raw); role {
+	case RolePrimary, RoleStandby:
+		return role, nil
+	default:
+		return "", fmt.Errorf("unknown replica role %q, expected primary or standby", raw)
+	}
+}
+
+type State struct {
+	region string
+	role   atomic.Value
+}
+
+func NewState(region string, role Role) *State {
+	s := &State{region: region}
+	s.role.Store(role)
+	return s
+}
+
+func (s *State) Region() string {
+	return s.region
+}
+
+func (s *State) Role() Role {
+	return s.role.Load().(Role)
+}
+
+func (s *State) Writable() bool {
+	return s.Role() == RolePrimary
+}
+
+func (s *State) SetRole(role Role) Role {
+	return s.role.Swap(role).(Role)
+}
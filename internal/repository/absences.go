@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const absenceSourceCalendar = "calendar"
+
+func (r *Repository) UpsertCalendarAbsences(ctx context.Context, tx pgx.Tx, absences []domain.Absence) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	for _, a := range absences {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO user_absences (user_id, source, external_id, starts_at, ends_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (source, external_id)
+			DO UPDATE SET starts_at = EXCLUDED.starts_at,
+			              ends_at = EXCLUDED.ends_at,
+			              updated_at = NOW()
+			WHERE user_absences.starts_at <> EXCLUDED.starts_at
+			   OR user_absences.ends_at <> EXCLUDED.ends_at
+		`, a.UserID, absenceSourceCalendar, a.ExternalID, a.StartsAt, a.EndsAt); err != nil {
+			return fmt.Errorf("upsert absence %s: %w", a.ExternalID, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) EndMissingCalendarAbsences(ctx context.Context, tx pgx.Tx, keepIDs []string, now time.Time) (int, error) {
+	if tx == nil {
+		return 0, errTxRequired
+	}
+	if keepIDs == nil {
+		keepIDs = []string{}
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE user_absences
+		SET ends_at = GREATEST(starts_at, $3),
+		    updated_at = NOW()
+		WHERE source = $1
+		  AND NOT (external_id = ANY($2::text[]))
+		  AND ends_at > $3
+	`, absenceSourceCalendar, keepIDs, now)
+	if err != nil {
+		return 0, fmt.Errorf("end missing absences: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *Repository) ApplyDueAbsences(ctx context.Context, tx pgx.Tx, now time.Time) ([]string, error) {
+	if tx == nil {
+		return nil, errTxRequired
+	}
+
+	rows, err := tx.Query(ctx, `
+		WITH due AS (
+			SELECT absence_id, user_id
+			FROM user_absences
+			WHERE applied_at IS NULL AND starts_at <= $1 AND ends_at > $1
+			FOR UPDATE
+		), deactivated AS (
+			UPDATE users u
+			SET is_active = FALSE,
+			    inactive_by_absence = TRUE,
+			    updated_at = NOW()
+			FROM (SELECT DISTINCT user_id FROM due) d
+			WHERE u.user_id = d.user_id AND u.is_active
+			RETURNING u.user_id
+		), marked AS (
+			UPDATE user_absences a
+			SET applied_at = $1,
+			    deactivated = a.user_id IN (SELECT user_id FROM deactivated)
+			FROM due
+			WHERE a.absence_id = due.absence_id
+		)
+		SELECT user_id FROM deactivated ORDER BY user_id
+	`, now)
+	if err != nil {
+		return nil, fmt.Errorf("apply due absences: %w", err)
+	}
+
+	return collectUserIDs(rows, "deactivated user")
+}
+
+func (r *Repository) RestoreEndedAbsences(ctx context.Context, tx pgx.Tx, now time.Time) ([]string, error) {
+	if tx == nil {
+		return nil, errTxRequired
+	}
+
+	rows, err := tx.Query(ctx, `
+		WITH ended AS (
+			SELECT a.absence_id, a.user_id
+			FROM user_absences a
+			WHERE a.deactivated AND a.restored_at IS NULL AND a.ends_at <= $1
+			  AND NOT EXISTS (
+			      SELECT 1 FROM user_absences o
+			      WHERE o.user_id = a.user_id
+			        AND o.applied_at IS NOT NULL
+			        AND o.starts_at <= $1 AND o.ends_at > $1
+			  )
+			FOR UPDATE OF a
+		), restored AS (
+			UPDATE users u
+			SET is_active = TRUE,
+			    inactive_by_absence = FALSE,
+			    updated_at = NOW()
+			FROM (SELECT DISTINCT user_id FROM ended) e
+			WHERE u.user_id = e.user_id AND NOT u.is_active AND u.inactive_by_absence
+			RETURNING u.user_id
+		), marked AS (
+			UPDATE user_absences a
+			SET restored_at = $1
+			FROM ended
+			WHERE a.absence_id = ended.absence_id
+		)
+		SELECT user_id FROM restored ORDER BY user_id
+	`, now)
+	if err != nil {
+		return nil, fmt.Errorf("restore ended absences: %w", err)
+	}
+
+	return collectUserIDs(rows, "restored user")
+}
+
+func collectUserIDs(rows pgx.Rows, what string) ([]string, error) {
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan %s: %w", what, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate %s: %w", what, err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const agingBucketColumns = `
+	COUNT(pr.pull_request_id) FILTER (WHERE pr.created_at > $1::timestamptz - INTERVAL '1 day'),
+	COUNT(pr.pull_request_id) FILTER (WHERE pr.created_at <= $1::timestamptz - INTERVAL '1 day' AND pr.created_at > $1::timestamptz - INTERVAL '3 days'),
+	COUNT(pr.pull_request_id) FILTER (WHERE pr.created_at <= $1::timestamptz - INTERVAL '3 days' AND pr.created_at > $1::timestamptz - INTERVAL '7 days'),
+	COUNT(pr.pull_request_id) FILTER (WHERE pr.created_at <= $1::timestamptz - INTERVAL '7 days')`
+
+func (r *Repository) CountTeamOpenPullRequestsByAge(ctx context.Context, teamID int64, now time.Time) (domain.AgeBuckets, error) {
+	var b domain.AgeBuckets
+	if err := r.pool.QueryRow(ctx, `
+		SELECT `+agingBucketColumns+`
+		FROM team_memberships tm
+		JOIN pull_requests pr ON pr.author_id = tm.user_id
+		WHERE tm.team_id = $2 AND pr.status_id = $3 AND pr.deleted_at IS NULL
+	`, now, teamID, prStatusOpenID).Scan(&b.Under1Day, &b.From1To3Day, &b.From3To7Day, &b.Over7Day); err != nil {
+		return domain.AgeBuckets{}, fmt.Errorf("count open pull requests by age: %w", err)
+	}
+	return b, nil
+}
+
+func (r *Repository) ListOldestOpenPullRequests(ctx context.Context, teamID int64, limit int) ([]domain.AgingPullRequest, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT pr.pull_request_id, `+r.prName()+`, pr.author_id, pr.created_at
+		FROM team_memberships tm
+		JOIN pull_requests pr ON pr.author_id = tm.user_id
+		WHERE tm.team_id = $1 AND pr.status_id = $2 AND pr.deleted_at IS NULL
+		ORDER BY pr.created_at, pr.pull_request_id
+		LIMIT $3
+	`, teamID, prStatusOpenID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("select oldest open pull requests: %w", err)
+	}
+	defer rows.Close()
+
+	prs := []domain.AgingPullRequest{}
+	for rows.Next() {
+		var pr domain.AgingPullRequest
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan oldest open pull request: %w", err)
+		}
+		prs = append(prs, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate oldest open pull requests: %w", err)
+	}
+
+	return prs, nil
+}
+
+func (r *Repository) SnapshotPullRequestAging(ctx context.Context, tx pgx.Tx, now time.Time) (int64, error) {
+	if tx == nil {
+		return 0, errTxRequired
+	}
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO pr_aging_snapshots (team_id, snapshot_date, under_1d, from_1d_to_3d, from_3d_to_7d, over_7d, taken_at)
+		SELECT t.team_id, ($1::timestamptz AT TIME ZONE 'UTC')::date, `+agingBucketColumns+`, $1
+		FROM teams t
+		LEFT JOIN (
+		    team_memberships tm
+		    JOIN pull_requests pr ON pr.author_id = tm.user_id AND pr.status_id = $2 AND pr.deleted_at IS NULL
+		) ON tm.team_id = t.team_id
+		GROUP BY t.team_id
+		ON CONFLICT (team_id, snapshot_date)
+		DO UPDATE SET under_1d = EXCLUDED.under_1d,
+		              from_1d_to_3d = EXCLUDED.from_1d_to_3d,
+		              from_3d_to_7d = EXCLUDED.from_3d_to_7d,
+		              over_7d = EXCLUDED.over_7d,
+		              taken_at = EXCLUDED.taken_at
+	`, now, prStatusOpenID)
+	if err != nil {
+		return 0, fmt.Errorf("snapshot pull request aging: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *Repository) ListAgingSnapshots(ctx context.Context, teamID int64, since time.Time) ([]domain.AgingSnapshot, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT snapshot_date, under_1d, from_1d_to_3d, from_3d_to_7d, over_7d
+		FROM pr_aging_snapshots
+		WHERE team_id = $1 AND snapshot_date >= ($2::timestamptz AT TIME ZONE 'UTC')::date
+		ORDER BY snapshot_date
+	`, teamID, since)
+	if err != nil {
+		return nil, fmt.Errorf("select aging snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	snapshots := []domain.AgingSnapshot{}
+	for rows.Next() {
+		var s domain.AgingSnapshot
+		if err := rows.Scan(&s.Date, &s.Buckets.Under1Day, &s.Buckets.From1To3Day, &s.Buckets.From3To7Day, &s.Buckets.Over7Day); err != nil {
+			return nil, fmt.Errorf("scan aging snapshot: %w", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate aging snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) InsertAnnouncement(ctx context.Context, tx pgx.Tx, a domain.Announcement) (domain.Announcement, error) {
+	if tx == nil {
+		return domain.Announcement{}, errTxRequired
+	}
+
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO announcements (message, severity, starts_at, ends_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING announcement_id, created_at
+	`, a.Message, string(a.Severity), a.StartsAt, a.EndsAt).Scan(&a.ID, &a.CreatedAt); err != nil {
+		return domain.Announcement{}, fmt.Errorf("insert announcement: %w", err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) ListUnexpiredAnnouncements(ctx context.Context, now time.Time) ([]domain.Announcement, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT announcement_id, message, severity, starts_at, ends_at, created_at
+		FROM announcements
+		WHERE ends_at IS NULL OR ends_at > $1
+		ORDER BY starts_at, announcement_id
+	`, now)
+	if err != nil {
+		return nil, fmt.Errorf("select announcements: %w", err)
+	}
+	defer rows.Close()
+
+	var announcements []domain.Announcement
+	for rows.Next() {
+		var a domain.Announcement
+		var severity string
+		var endsAt sql.NullTime
+		if err := rows.Scan(&a.ID, &a.Message, &severity, &a.StartsAt, &endsAt, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan announcement: %w", err)
+		}
+		a.Severity = domain.AnnouncementSeverity(severity)
+		if endsAt.Valid {
+			t := endsAt.Time
+			a.EndsAt = &t
+		}
+		announcements = append(announcements, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate announcements: %w", err)
+	}
+
+	return announcements, nil
+}
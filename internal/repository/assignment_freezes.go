@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const assignmentFreezeColumns = `freeze_id, reason, starts_at, ends_at, lifted_at, created_at`
+
+func (r *Repository) InsertAssignmentFreeze(ctx context.Context, tx pgx.Tx, f domain.AssignmentFreeze) (domain.AssignmentFreeze, error) {
+	if tx == nil {
+		return domain.AssignmentFreeze{}, errTxRequired
+	}
+
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO assignment_freezes (reason, starts_at, ends_at)
+		VALUES ($1, $2, $3)
+		RETURNING freeze_id, created_at
+	`, f.Reason, f.StartsAt, f.EndsAt).Scan(&f.ID, &f.CreatedAt); err != nil {
+		return domain.AssignmentFreeze{}, fmt.Errorf("insert assignment freeze: %w", err)
+	}
+
+	return f, nil
+}
+
+func (r *Repository) ListAssignmentFreezes(ctx context.Context, now time.Time) ([]domain.AssignmentFreeze, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+assignmentFreezeColumns+`
+		FROM assignment_freezes
+		WHERE lifted_at IS NULL AND ends_at > $1
+		ORDER BY starts_at, freeze_id
+	`, now)
+	if err != nil {
+		return nil, fmt.Errorf("select assignment freezes: %w", err)
+	}
+	defer rows.Close()
+
+	var freezes []domain.AssignmentFreeze
+	for rows.Next() {
+		f, err := scanAssignmentFreeze(rows)
+		if err != nil {
+			return nil, err
+		}
+		freezes = append(freezes, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate assignment freezes: %w", err)
+	}
+
+	return freezes, nil
+}
+
+func (r *Repository) IsAssignmentFrozen(ctx context.Context, now time.Time) (bool, error) {
+	var frozen bool
+	if err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM assignment_freezes
+			WHERE lifted_at IS NULL AND starts_at <= $1 AND ends_at > $1
+		)
+	`, now).Scan(&frozen); err != nil {
+		return false, fmt.Errorf("select active assignment freeze: %w", err)
+	}
+	return frozen, nil
+}
+
+func (r *Repository) LiftAssignmentFreeze(ctx context.Context, tx pgx.Tx, freezeID int64, now time.Time) (domain.AssignmentFreeze, error) {
+	if tx == nil {
+		return domain.AssignmentFreeze{}, errTxRequired
+	}
+
+	f, err := scanAssignmentFreeze(tx.QueryRow(ctx, `
+		UPDATE assignment_freezes
+		SET lifted_at = $2
+		WHERE freeze_id = $1 AND lifted_at IS NULL AND ends_at > $2
+		RETURNING `+assignmentFreezeColumns, freezeID, now))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.AssignmentFreeze{}, ErrFreezeNotFound
+	}
+	return f, err
+}
+
+func (r *Repository) ListDeferredPullRequests(ctx context.Context) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT pull_request_id
+		FROM pull_requests
+		WHERE assignment_deferred_at IS NOT NULL
+		  AND status_id = $1
+		  AND deleted_at IS NULL
+		ORDER BY assignment_deferred_at, pull_request_id
+	`, prStatusOpenID)
+	if err != nil {
+		return nil, fmt.Errorf("select deferred pull requests: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan deferred pull request: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate deferred pull requests: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *Repository) ClearAssignmentDeferred(ctx context.Context, tx pgx.Tx, prID string, reviewDueAt *time.Time) (bool, error) {
+	if tx == nil {
+		return false, errTxRequired
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE pull_requests
+		SET assignment_deferred_at = NULL,
+		    review_due_at = $3
+		WHERE pull_request_id = $1
+		  AND assignment_deferred_at IS NOT NULL
+		  AND status_id = $2
+		  AND deleted_at IS NULL
+	`, prID, prStatusOpenID, reviewDueAt)
+	if err != nil {
+		return false, fmt.Errorf("clear assignment deferral: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func scanAssignmentFreeze(row pgx.Row) (domain.AssignmentFreeze, error) {
+	var f domain.AssignmentFreeze
+	var liftedAt sql.NullTime
+	if err := row.Scan(&f.ID, &f.Reason, &f.StartsAt, &f.EndsAt, &liftedAt, &f.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.AssignmentFreeze{}, err
+		}
+		return domain.AssignmentFreeze{}, fmt.Errorf("scan assignment freeze: %w", err)
+	}
+	if liftedAt.Valid {
+		t := liftedAt.Time
+		f.LiftedAt = &t
+	}
+	return f, nil
+}
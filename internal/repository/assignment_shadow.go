@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) InsertAssignmentShadow(ctx context.Context, tx pgx.Tx, teamID int64, sample domain.AssignmentShadowSample) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO assignment_shadow_log (pull_request_id, team_id, primary_reviewers, shadow_reviewers, diverged)
+		VALUES ($1, $2, $3, $4, $5)
+	`, sample.PullRequestID, teamID, sample.PrimaryReviewers, sample.ShadowReviewers, sample.Diverged); err != nil {
+		return fmt.Errorf("insert assignment shadow: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetAssignmentShadowReport(ctx context.Context, teamID *int64, recentLimit int) (domain.AssignmentShadowReport, error) {
+	var report domain.AssignmentShadowReport
+	if err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE diverged)
+		FROM assignment_shadow_log
+		WHERE $1::bigint IS NULL OR team_id = $1
+	`, teamID).Scan(&report.Total, &report.Diverged); err != nil {
+		return domain.AssignmentShadowReport{}, fmt.Errorf("select assignment shadow totals: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT pull_request_id, primary_reviewers, shadow_reviewers, diverged, created_at
+		FROM assignment_shadow_log
+		WHERE $1::bigint IS NULL OR team_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`, teamID, recentLimit)
+	if err != nil {
+		return domain.AssignmentShadowReport{}, fmt.Errorf("select assignment shadow samples: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sample domain.AssignmentShadowSample
+		if err := rows.Scan(&sample.PullRequestID, &sample.PrimaryReviewers, &sample.ShadowReviewers, &sample.Diverged, &sample.CreatedAt); err != nil {
+			return domain.AssignmentShadowReport{}, fmt.Errorf("scan assignment shadow sample: %w", err)
+		}
+		report.Recent = append(report.Recent, sample)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.AssignmentShadowReport{}, fmt.Errorf("iterate assignment shadow samples: %w", err)
+	}
+
+	return report, nil
+}
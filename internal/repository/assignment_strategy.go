@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) SetTeamAssignmentStrategy(ctx context.Context, tx pgx.Tx, teamID int64, strategy domain.AssignmentStrategyKind) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE teams SET assignment_strategy = NULLIF($2, '') WHERE team_id = $1
+	`, teamID, string(strategy)); err != nil {
+		return fmt.Errorf("update assignment strategy: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetTeamAssignmentStrategy(ctx context.Context, teamID int64) (domain.AssignmentStrategyKind, error) {
+	var strategy domain.AssignmentStrategyKind
+	if err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(assignment_strategy, '') FROM teams WHERE team_id = $1
+	`, teamID).Scan(&strategy); err != nil {
+		return "", fmt.Errorf("select assignment strategy: %w", err)
+	}
+	return strategy, nil
+}
+
+func (r *Repository) LockAssignmentCursor(ctx context.Context, tx pgx.Tx, teamID int64) (string, error) {
+	if tx == nil {
+		return "", errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO team_assignment_cursors (team_id, last_user_id)
+		VALUES ($1, '')
+		ON CONFLICT (team_id) DO NOTHING
+	`, teamID); err != nil {
+		return "", fmt.Errorf("insert assignment cursor: %w", err)
+	}
+
+	var lastUserID string
+	if err := tx.QueryRow(ctx, `
+		SELECT last_user_id FROM team_assignment_cursors WHERE team_id = $1 FOR UPDATE
+	`, teamID).Scan(&lastUserID); err != nil {
+		return "", fmt.Errorf("lock assignment cursor: %w", err)
+	}
+	return lastUserID, nil
+}
+
+func (r *Repository) SetAssignmentCursor(ctx context.Context, tx pgx.Tx, teamID int64, lastUserID string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO team_assignment_cursors (team_id, last_user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (team_id) DO UPDATE SET last_user_id = EXCLUDED.last_user_id
+	`, teamID, lastUserID); err != nil {
+		return fmt.Errorf("upsert assignment cursor: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) listReviewerAssignments(ctx context.Context, q querier, prID string) ([]domain.ReviewerAssignment, error) {
+	rows, err := q.Query(ctx, `
+		SELECT rr.reviewer_id, rr.kind, rr.assigned_at, rr.first_response_at, rr.completed_at, rr.snoozed_until,
+		       rr.snooze_used_seconds, rr.handoff_from, rr.handoff_note, rv.approved_at
+		FROM pr_reviewers rr
+		LEFT JOIN pr_reviews rv ON rv.pull_request_id = rr.pull_request_id AND rv.reviewer_id = rr.reviewer_id
+		WHERE rr.pull_request_id = $1
+		ORDER BY rr.assigned_at
+	`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("select reviewer assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []domain.ReviewerAssignment
+	for rows.Next() {
+		var a domain.ReviewerAssignment
+		var firstResponseAt, completedAt, snoozedUntil, approvedAt sql.NullTime
+		var snoozeUsedSeconds int64
+		var kind string
+		var handoffFrom, handoffNote sql.NullString
+		if err := rows.Scan(&a.ReviewerID, &kind, &a.AssignedAt, &firstResponseAt, &completedAt, &snoozedUntil, &snoozeUsedSeconds, &handoffFrom, &handoffNote, &approvedAt); err != nil {
+			return nil, fmt.Errorf("scan reviewer assignment: %w", err)
+		}
+		if firstResponseAt.Valid {
+			t := firstResponseAt.Time
+			a.FirstResponseAt = &t
+		}
+		if completedAt.Valid {
+			t := completedAt.Time
+			a.CompletedAt = &t
+		}
+		if snoozedUntil.Valid {
+			t := snoozedUntil.Time
+			a.SnoozedUntil = &t
+		}
+		if approvedAt.Valid {
+			t := approvedAt.Time
+			a.ApprovedAt = &t
+		}
+		a.Kind = domain.AssignmentKind(kind)
+		a.SnoozeUsed = time.Duration(snoozeUsedSeconds) * time.Second
+		if handoffFrom.Valid {
+			a.Handoff = &domain.Handoff{FromReviewerID: handoffFrom.String, Note: handoffNote.String}
+		}
+		assignments = append(assignments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reviewer assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+func (r *Repository) ListOpenAssignments(ctx context.Context, reviewerID string) ([]domain.OpenAssignment, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT rr.pull_request_id, rr.kind
+		FROM pr_reviewers rr
+		JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+		WHERE rr.reviewer_id = $1 AND pr.status_id = $2 AND pr.deleted_at IS NULL
+		ORDER BY pr.created_at, pr.pull_request_id
+	`, reviewerID, prStatusOpenID)
+	if err != nil {
+		return nil, fmt.Errorf("select open assignments: %w", err)
+	}
+	defer rows.Close()
+
+	assignments := []domain.OpenAssignment{}
+	for rows.Next() {
+		var a domain.OpenAssignment
+		var kind string
+		if err := rows.Scan(&a.PullRequestID, &kind); err != nil {
+			return nil, fmt.Errorf("scan open assignment: %w", err)
+		}
+		a.Kind = domain.AssignmentKind(kind)
+		assignments = append(assignments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate open assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+func (r *Repository) MarkReviewerResponded(ctx context.Context, tx pgx.Tx, prID, reviewerID string, at time.Time) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE pr_reviewers
+		SET first_response_at = COALESCE(first_response_at, $3),
+		    approval_reset_at = NULL
+		WHERE pull_request_id = $1 AND reviewer_id = $2
+	`, prID, reviewerID, at); err != nil {
+		return fmt.Errorf("mark reviewer responded: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListFirstResponseStats(ctx context.Context, teamID *int64) ([]domain.FirstResponseStats, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT t.team_name,
+		       COUNT(rr.first_response_at),
+		       COUNT(*) - COUNT(rr.first_response_at),
+		       COALESCE(percentile_cont(0.5) WITHIN GROUP (
+		           ORDER BY EXTRACT(EPOCH FROM rr.first_response_at - rr.assigned_at)
+		       ), 0)
+		FROM pr_reviewers rr
+		JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+		JOIN team_memberships tm ON tm.user_id = pr.author_id
+		JOIN teams t ON t.team_id = tm.team_id
+		WHERE rr.kind = 'regular' AND pr.deleted_at IS NULL AND ($1::bigint IS NULL OR t.team_id = $1)
+		GROUP BY t.team_name
+		ORDER BY t.team_name
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("select first response stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []domain.FirstResponseStats
+	for rows.Next() {
+		var st domain.FirstResponseStats
+		var medianSeconds float64
+		if err := rows.Scan(&st.TeamName, &st.Responded, &st.Pending, &medianSeconds); err != nil {
+			return nil, fmt.Errorf("scan first response stats: %w", err)
+		}
+		st.MedianFirstResponse = time.Duration(medianSeconds * float64(time.Second))
+		stats = append(stats, st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate first response stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (r *Repository) ResetApprovals(ctx context.Context, tx pgx.Tx, prID string, reviewDueAt *time.Time, at time.Time) ([]string, error) {
+	if tx == nil {
+		return nil, errTxRequired
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE pull_requests
+		SET approvals_reset_at = $2,
+		    review_due_at = $3
+		WHERE pull_request_id = $1 AND status_id = $4 AND deleted_at IS NULL
+	`, prID, at, reviewDueAt, prStatusOpenID)
+	if err != nil {
+		return nil, fmt.Errorf("reset pull request approvals: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrPullRequestNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM pr_checklist_checks WHERE pull_request_id = $1`, prID); err != nil {
+		return nil, fmt.Errorf("clear checklist checks: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM pr_reviews WHERE pull_request_id = $1`, prID); err != nil {
+		return nil, fmt.Errorf("clear review approvals: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		UPDATE pr_reviewers
+		SET completed_at = NULL,
+		    approval_reset_at = $2
+		WHERE pull_request_id = $1 AND (first_response_at IS NOT NULL OR completed_at IS NOT NULL)
+		RETURNING reviewer_id
+	`, prID, at)
+	if err != nil {
+		return nil, fmt.Errorf("reset reviewer responses: %w", err)
+	}
+
+	return collectUserIDs(rows, "reset reviewer")
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (r *Repository) CountPullRequestsByAuthorSince(ctx context.Context, authorID string, since time.Time) (int, error) {
+	var count int
+	if err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM pull_requests
+		WHERE author_id = $1 AND created_at >= $2
+	`, authorID, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count author pull requests: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/migrations"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// benchSizes is the number of pull requests seeded for each sub-benchmark.
+var benchSizes = []int{100, 1_000, 10_000}
+
+const benchTeamSize = 20
+
+type benchData struct {
+	teamID   int64
+	reviewer string
+	prID     string
+}
+
+// newBenchRepository connects to DATABASE_URL and migrates it. The benchmarks
+// write data under unique ids, so point DATABASE_URL at a throwaway database,
+// e.g. the docker-compose Postgres.
+func newBenchRepository(b *testing.B) *Repository {
+	b.Helper()
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		b.Skip("DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+	if err := migrations.Run(ctx, databaseURL, time.Minute, false, nil); err != nil {
+		b.Fatalf("migrate: %v", err)
+	}
+	pool, err := pgxpool.New(ctx, databaseURL)
+	if err != nil {
+		b.Fatalf("connect: %v", err)
+	}
+	b.Cleanup(pool.Close)
+
+	return New(pool, nil, 0, Compat{}, nil)
+}
+
+// seedBench creates one team and size open pull requests, each with two
+// reviewers. Every pull request lists the same first reviewer, so the reviewer
+// queue grows with size.
+func seedBench(b *testing.B, repo *Repository, size int) benchData {
+	b.Helper()
+	prefix := fmt.Sprintf("bench-%d-%d", size, time.Now().UnixNano())
+
+	var data benchData
+	err := repo.RunInTx(context.Background(), func(ctx context.Context, tx pgx.Tx) error {
+		team, err := repo.InsertTeam(ctx, tx, prefix)
+		if err != nil {
+			return err
+		}
+		data.teamID = team.ID
+
+		users := make([]string, benchTeamSize)
+		for i := range users {
+			users[i] = fmt.Sprintf("%s-u%d", prefix, i)
+			if _, err := repo.UpsertUser(ctx, tx, domain.User{ID: users[i], Username: users[i], IsActive: true}); err != nil {
+				return err
+			}
+			if err := repo.UpsertMembership(ctx, tx, team.ID, users[i]); err != nil {
+				return err
+			}
+		}
+		data.reviewer = users[0]
+
+		for i := 0; i < size; i++ {
+			pr := domain.PullRequest{
+				ID:       fmt.Sprintf("%s-pr%d", prefix, i),
+				Name:     fmt.Sprintf("Change %d", i),
+				AuthorID: users[1+i%(benchTeamSize-1)],
+			}
+			if _, err := repo.CreatePullRequest(ctx, tx, pr); err != nil {
+				return err
+			}
+			second := users[1+(i+1)%(benchTeamSize-1)]
+			if err := repo.AddReviewers(ctx, tx, pr.ID, []string{users[0], second}); err != nil {
+				return err
+			}
+			data.prID = pr.ID
+		}
+		return nil
+	})
+	if err != nil {
+		b.Fatalf("seed %d pull requests: %v", size, err)
+	}
+	return data
+}
+
+// runSized seeds each size and runs fn under a sub-benchmark. fn returns the
+// number of rows the query returned, reported as rows/op next to ns/op.
+func runSized(b *testing.B, fn func(context.Context, *Repository, benchData) (int, error)) {
+	repo := newBenchRepository(b)
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("prs=%d", size), func(b *testing.B) {
+			data := seedBench(b, repo, size)
+			ctx := context.Background()
+
+			var rows int
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				n, err := fn(ctx, repo, data)
+				if err != nil {
+					b.Fatal(err)
+				}
+				rows += n
+			}
+			b.ReportMetric(float64(rows)/float64(b.N), "rows/op")
+		})
+	}
+}
+
+func BenchmarkListLeastLoadedActiveTeamMembers(b *testing.B) {
+	runSized(b, func(ctx context.Context, repo *Repository, data benchData) (int, error) {
+		members, err := repo.ListLeastLoadedActiveTeamMembers(ctx, data.teamID, []string{data.reviewer}, 2)
+		return len(members), err
+	})
+}
+
+func BenchmarkListPullRequestsForReviewer(b *testing.B) {
+	runSized(b, func(ctx context.Context, repo *Repository, data benchData) (int, error) {
+		prs, err := repo.ListPullRequestsForReviewer(ctx, data.reviewer, domain.Page{SortBy: "created_at", Limit: 50}, false)
+		return len(prs), err
+	})
+}
+
+func BenchmarkGetPullRequest(b *testing.B) {
+	runSized(b, func(ctx context.Context, repo *Repository, data benchData) (int, error) {
+		_, err := repo.GetPullRequest(ctx, data.prID)
+		return 1, err
+	})
+}
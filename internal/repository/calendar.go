@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) GetTeamCalendar(ctx context.Context, teamID int64) (domain.Calendar, error) {
+	var cal domain.Calendar
+	var startMinutes, endMinutes int
+	var workdays []int32
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT timezone, work_start_minutes, work_end_minutes, workdays, holidays::text[]
+		FROM team_calendars
+		WHERE team_id = $1
+	`, teamID).Scan(&cal.Timezone, &startMinutes, &endMinutes, &workdays, &cal.Holidays)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Calendar{}, ErrCalendarNotFound
+	}
+	if err != nil {
+		return domain.Calendar{}, fmt.Errorf("select team calendar: %w", err)
+	}
+
+	cal.WorkStart = time.Duration(startMinutes) * time.Minute
+	cal.WorkEnd = time.Duration(endMinutes) * time.Minute
+	for _, d := range workdays {
+		cal.Workdays = append(cal.Workdays, time.Weekday(d))
+	}
+
+	return cal, nil
+}
+
+func (r *Repository) UpsertTeamCalendar(ctx context.Context, tx pgx.Tx, teamID int64, cal domain.Calendar) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	workdays := make([]int32, 0, len(cal.Workdays))
+	for _, d := range cal.Workdays {
+		workdays = append(workdays, int32(d))
+	}
+	holidays := cal.Holidays
+	if holidays == nil {
+		holidays = []string{}
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO team_calendars (team_id, timezone, work_start_minutes, work_end_minutes, workdays, holidays)
+		VALUES ($1, $2, $3, $4, $5, $6::date[])
+		ON CONFLICT (team_id)
+		DO UPDATE SET timezone = EXCLUDED.timezone,
+		              work_start_minutes = EXCLUDED.work_start_minutes,
+		              work_end_minutes = EXCLUDED.work_end_minutes,
+		              workdays = EXCLUDED.workdays,
+		              holidays = EXCLUDED.holidays,
+		              updated_at = NOW()
+	`, teamID, cal.Timezone, int(cal.WorkStart/time.Minute), int(cal.WorkEnd/time.Minute), workdays, holidays); err != nil {
+		return fmt.Errorf("upsert team calendar: %w", err)
+	}
+
+	return nil
+}
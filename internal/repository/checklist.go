@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) ReplaceChecklist(ctx context.Context, tx pgx.Tx, teamID int64, titles []string, required bool) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE teams SET checklist_required = $2 WHERE team_id = $1
+	`, teamID, required); err != nil {
+		return fmt.Errorf("update checklist requirement: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM team_checklist_items
+		WHERE team_id = $1 AND title <> ALL($2::text[])
+	`, teamID, titles); err != nil {
+		return fmt.Errorf("delete checklist items: %w", err)
+	}
+
+	for i, title := range titles {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO team_checklist_items (team_id, title, position)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (team_id, title) DO UPDATE SET position = EXCLUDED.position
+		`, teamID, title, i); err != nil {
+			return fmt.Errorf("upsert checklist item: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) listChecklistItems(ctx context.Context, teamID int64) ([]domain.ChecklistItem, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT item_id, title
+		FROM team_checklist_items
+		WHERE team_id = $1
+		ORDER BY position
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("select checklist items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domain.ChecklistItem
+	for rows.Next() {
+		var item domain.ChecklistItem
+		if err := rows.Scan(&item.ID, &item.Title); err != nil {
+			return nil, fmt.Errorf("scan checklist item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate checklist items: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *Repository) listPullRequestChecklist(ctx context.Context, q querier, prID string) ([]domain.ChecklistItemState, error) {
+	rows, err := q.Query(ctx, `
+		SELECT ci.item_id, ci.title, c.checked_by, c.checked_at
+		FROM pull_requests pr
+		JOIN team_memberships tm ON tm.user_id = pr.author_id
+		JOIN team_checklist_items ci ON ci.team_id = tm.team_id
+		LEFT JOIN pr_checklist_checks c ON c.pull_request_id = pr.pull_request_id AND c.item_id = ci.item_id
+		WHERE pr.pull_request_id = $1
+		ORDER BY ci.position
+	`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("select pull request checklist: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domain.ChecklistItemState
+	for rows.Next() {
+		var item domain.ChecklistItemState
+		var checkedBy sql.NullString
+		var checkedAt sql.NullTime
+		if err := rows.Scan(&item.ItemID, &item.Title, &checkedBy, &checkedAt); err != nil {
+			return nil, fmt.Errorf("scan pull request checklist: %w", err)
+		}
+		if checkedBy.Valid {
+			by := checkedBy.String
+			item.CheckedBy = &by
+		}
+		if checkedAt.Valid {
+			at := checkedAt.Time
+			item.CheckedAt = &at
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate pull request checklist: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *Repository) CheckChecklistItem(ctx context.Context, tx pgx.Tx, prID string, itemID int64, userID string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO pr_checklist_checks (pull_request_id, item_id, checked_by)
+		SELECT pr.pull_request_id, ci.item_id, $3
+		FROM pull_requests pr
+		JOIN team_memberships tm ON tm.user_id = pr.author_id
+		JOIN team_checklist_items ci ON ci.team_id = tm.team_id AND ci.item_id = $2
+		WHERE pr.pull_request_id = $1
+		ON CONFLICT (pull_request_id, item_id)
+		DO UPDATE SET checked_by = EXCLUDED.checked_by,
+		              checked_at = NOW()
+	`, prID, itemID, userID)
+	if err != nil {
+		return fmt.Errorf("check checklist item: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrChecklistItemNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) UncheckChecklistItem(ctx context.Context, tx pgx.Tx, prID string, itemID int64) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM pr_checklist_checks
+		WHERE pull_request_id = $1 AND item_id = $2
+	`, prID, itemID); err != nil {
+		return fmt.Errorf("uncheck checklist item: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) IsChecklistRequired(ctx context.Context, prID string) (bool, error) {
+	var required bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(bool_or(t.checklist_required), FALSE)
+		FROM pull_requests pr
+		JOIN team_memberships tm ON tm.user_id = pr.author_id
+		JOIN teams t ON t.team_id = tm.team_id
+		WHERE pr.pull_request_id = $1
+	`, prID).Scan(&required)
+	if err != nil {
+		return false, fmt.Errorf("select checklist requirement: %w", err)
+	}
+
+	return required, nil
+}
@@ -0,0 +1,24 @@
+package repository
+
+type Compat struct {
+	DualReadPullRequestTitle bool
+}
+
+func dualRead(enabled bool, newColumn, oldColumn string) string {
+	if !enabled {
+		return oldColumn
+	}
+	return "COALESCE(" + newColumn + ", " + oldColumn + ")"
+}
+
+func (r *Repository) prName() string {
+	return dualRead(r.compat.DualReadPullRequestTitle, "pr.title", "pr.pull_request_name")
+}
+
+func (r *Repository) pullRequestSortColumns() map[string]string {
+	return map[string]string{
+		"created_at": "pr.created_at",
+		"name":       r.prName(),
+		"id":         "pr.pull_request_id",
+	}
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+var conflictSortColumns = map[string]string{
+	"declared_at": "declared_at",
+}
+
+func (r *Repository) RecordReviewerConflict(ctx context.Context, tx pgx.Tx, d domain.ConflictDeclaration) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO pr_reviewer_conflicts (pull_request_id, reviewer_id, category, details, replaced_by, declared_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (pull_request_id, reviewer_id) DO UPDATE
+		SET category = EXCLUDED.category,
+		    details = EXCLUDED.details,
+		    replaced_by = EXCLUDED.replaced_by,
+		    declared_at = EXCLUDED.declared_at
+	`, d.PullRequestID, d.ReviewerID, string(d.Category), d.Details, d.ReplacedBy, d.DeclaredAt); err != nil {
+		return fmt.Errorf("insert reviewer conflict: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) ListConflictedReviewers(ctx context.Context, prID string) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT reviewer_id FROM pr_reviewer_conflicts WHERE pull_request_id = $1
+	`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("select conflicted reviewers: %w", err)
+	}
+
+	return collectUserIDs(rows, "conflicted reviewer")
+}
+
+func (r *Repository) ListReviewerConflicts(ctx context.Context, page domain.Page) ([]domain.ConflictDeclaration, error) {
+	order, err := pageClause(page, conflictSortColumns, "(pull_request_id, reviewer_id)")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT pull_request_id, reviewer_id, category, details, replaced_by, declared_at
+		FROM pr_reviewer_conflicts
+		`+order)
+	if err != nil {
+		return nil, fmt.Errorf("select reviewer conflicts: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.ConflictDeclaration
+	for rows.Next() {
+		var d domain.ConflictDeclaration
+		var category string
+		if err := rows.Scan(&d.PullRequestID, &d.ReviewerID, &category, &d.Details, &d.ReplacedBy, &d.DeclaredAt); err != nil {
+			return nil, fmt.Errorf("scan reviewer conflict: %w", err)
+		}
+		d.Category = domain.ConflictCategory(category)
+		result = append(result, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reviewer conflicts: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+var consistencyQueries = []struct {
+	check domain.ConsistencyCheck
+	query string
+	args  []any
+}{
+	{
+		check: domain.ConsistencyReviewerInactive,
+		query: `
+			SELECT rr.pull_request_id || '/' || rr.reviewer_id,
+			       'reviewer ' || rr.reviewer_id || ' of open pull request ' || rr.pull_request_id || ' is inactive'
+			FROM pr_reviewers rr
+			JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+			JOIN users u ON u.user_id = rr.reviewer_id
+			WHERE pr.status_id = $1 AND pr.deleted_at IS NULL AND NOT u.is_active
+			ORDER BY 1`,
+		args: []any{prStatusOpenID},
+	},
+	{
+		check: domain.ConsistencyReviewerNotInTeam,
+		query: `
+			SELECT rr.pull_request_id || '/' || rr.reviewer_id,
+			       'reviewer ' || rr.reviewer_id || ' of open pull request ' || rr.pull_request_id ||
+			       ' is not a member of the author''s team'
+			FROM pr_reviewers rr
+			JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+			JOIN team_memberships am ON am.user_id = pr.author_id
+			WHERE pr.status_id = $1
+			  AND pr.deleted_at IS NULL
+			  AND NOT EXISTS (
+			      SELECT 1 FROM team_memberships rm
+			      WHERE rm.user_id = rr.reviewer_id AND rm.team_id = am.team_id
+			  )
+			ORDER BY 1`,
+		args: []any{prStatusOpenID},
+	},
+	{
+		check: domain.ConsistencyAuthorIsReviewer,
+		query: `
+			SELECT rr.pull_request_id || '/' || rr.reviewer_id,
+			       'author ' || rr.reviewer_id || ' is assigned as a reviewer of ' || rr.pull_request_id
+			FROM pr_reviewers rr
+			JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+			WHERE pr.author_id = rr.reviewer_id
+			ORDER BY 1`,
+	},
+	{
+		check: domain.ConsistencyMergedWithoutTime,
+		query: `
+			SELECT pull_request_id, 'merged pull request ' || pull_request_id || ' has no merged_at'
+			FROM pull_requests
+			WHERE status_id = $1 AND merged_at IS NULL
+			ORDER BY 1`,
+		args: []any{prStatusMergedID},
+	},
+	{
+		check: domain.ConsistencyOpenWithMergeTime,
+		query: `
+			SELECT pull_request_id, 'open pull request ' || pull_request_id || ' has merged_at set'
+			FROM pull_requests
+			WHERE status_id = $1 AND merged_at IS NOT NULL
+			ORDER BY 1`,
+		args: []any{prStatusOpenID},
+	},
+	{
+		check: domain.ConsistencyOrphanedMembership,
+		query: `
+			SELECT tm.team_id || '/' || tm.user_id,
+			       'membership of ' || tm.user_id || ' references missing ' ||
+			       CASE WHEN t.team_id IS NULL THEN 'team ' || tm.team_id ELSE 'user' END
+			FROM team_memberships tm
+			LEFT JOIN teams t ON t.team_id = tm.team_id
+			LEFT JOIN users u ON u.user_id = tm.user_id
+			WHERE t.team_id IS NULL OR u.user_id IS NULL
+			ORDER BY 1`,
+	},
+}
+
+func (r *Repository) ListConsistencyViolations(ctx context.Context) ([]domain.ConsistencyViolation, error) {
+	var violations []domain.ConsistencyViolation
+	for _, c := range consistencyQueries {
+		found, err := r.listViolations(ctx, c.check, c.query, c.args)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, found...)
+	}
+	return violations, nil
+}
+
+func (r *Repository) listViolations(ctx context.Context, check domain.ConsistencyCheck, query string, args []any) ([]domain.ConsistencyViolation, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("run consistency check %s: %w", check, err)
+	}
+	defer rows.Close()
+
+	var violations []domain.ConsistencyViolation
+	for rows.Next() {
+		v := domain.ConsistencyViolation{Check: check}
+		if err := rows.Scan(&v.Subject, &v.Detail); err != nil {
+			return nil, fmt.Errorf("scan consistency violation: %w", err)
+		}
+		violations = append(violations, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate consistency check %s: %w", check, err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	btreeTupleOverhead = 12
+	btreeFillFactor    = 0.9
+	btreeMinBytes      = 2 * 8192
+)
+
+func (r *Repository) GetDBStats(ctx context.Context) (domain.DBStats, error) {
+	tables, err := r.listTableStats(ctx)
+	if err != nil {
+		return domain.DBStats{}, err
+	}
+	indexes, err := r.listIndexStats(ctx)
+	if err != nil {
+		return domain.DBStats{}, err
+	}
+	return domain.DBStats{Tables: tables, Indexes: indexes}, nil
+}
+
+func (r *Repository) AnalyzeTable(ctx context.Context, table string) error {
+	if _, err := r.pool.Exec(ctx, "ANALYZE "+pgx.Identifier{table}.Sanitize()); err != nil {
+		return fmt.Errorf("analyze %s: %w", table, err)
+	}
+	return nil
+}
+
+func (r *Repository) listTableStats(ctx context.Context) ([]domain.TableStats, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT relname,
+		       n_live_tup,
+		       n_dead_tup,
+		       n_mod_since_analyze,
+		       pg_total_relation_size(relid),
+		       pg_relation_size(relid),
+		       GREATEST(last_analyze, last_autoanalyze)
+		FROM pg_stat_user_tables
+		WHERE schemaname = current_schema()
+		ORDER BY pg_total_relation_size(relid) DESC, relname
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("select table stats: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []domain.TableStats
+	for rows.Next() {
+		var t domain.TableStats
+		if err := rows.Scan(&t.Name, &t.LiveRows, &t.DeadRows, &t.ModifiedSinceAnalyze,
+			&t.TotalBytes, &t.TableBytes, &t.LastAnalyzedAt); err != nil {
+			return nil, fmt.Errorf("scan table stats: %w", err)
+		}
+		tables = append(tables, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate table stats: %w", err)
+	}
+
+	return tables, nil
+}
+
+func (r *Repository) listIndexStats(ctx context.Context) ([]domain.IndexStats, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.indexrelname,
+		       s.relname,
+		       pg_relation_size(s.indexrelid),
+		       s.idx_scan,
+		       GREATEST(ic.reltuples, 0)::float8,
+		       COALESCE((
+		           SELECT SUM(st.avg_width)
+		           FROM pg_attribute a
+		           JOIN pg_stats st ON st.schemaname = s.schemaname
+		                           AND st.tablename = s.relname
+		                           AND st.attname = a.attname
+		           WHERE a.attrelid = i.indrelid AND a.attnum = ANY (i.indkey)
+		       ), 0)::float8
+		FROM pg_stat_user_indexes s
+		JOIN pg_index i ON i.indexrelid = s.indexrelid
+		JOIN pg_class ic ON ic.oid = s.indexrelid
+		WHERE s.schemaname = current_schema()
+		ORDER BY pg_relation_size(s.indexrelid) DESC, s.indexrelname
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("select index stats: %w", err)
+	}
+	defer rows.Close()
+
+	var indexes []domain.IndexStats
+	for rows.Next() {
+		var idx domain.IndexStats
+		var tuples, keyWidth float64
+		if err := rows.Scan(&idx.Name, &idx.Table, &idx.Bytes, &idx.Scans, &tuples, &keyWidth); err != nil {
+			return nil, fmt.Errorf("scan index stats: %w", err)
+		}
+		expected := max(int64(math.Ceil(tuples*(keyWidth+btreeTupleOverhead)/btreeFillFactor)), btreeMinBytes)
+		if idx.Bytes > expected {
+			idx.EstimatedBloatBytes = idx.Bytes - expected
+		}
+		indexes = append(indexes, idx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate index stats: %w", err)
+	}
+
+	return indexes, nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) RecordReviewerDecline(ctx context.Context, tx pgx.Tx, d domain.ReviewerDecline) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	var reason *string
+	if d.Reason != "" {
+		value := string(d.Reason)
+		reason = &value
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO reviewer_declines (pull_request_id, reviewer_id, team_id, reason, note, replaced_by, declined_at)
+		VALUES ($1, $2, (SELECT team_id FROM team_memberships WHERE user_id = $2 ORDER BY joined_at LIMIT 1), $3, $4, $5, $6)
+	`, d.PullRequestID, d.ReviewerID, reason, d.Note, d.ReplacedBy, d.DeclinedAt); err != nil {
+		return fmt.Errorf("insert reviewer decline: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) CountDeclineReasons(ctx context.Context, teamID int64, since *time.Time) (map[domain.DeclineReason]int, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT COALESCE(reason, ''), COUNT(*)
+		FROM reviewer_declines
+		WHERE team_id = $1
+		  AND ($2::TIMESTAMPTZ IS NULL OR declined_at >= $2)
+		GROUP BY 1
+	`, teamID, since)
+	if err != nil {
+		return nil, fmt.Errorf("count decline reasons: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[domain.DeclineReason]int)
+	for rows.Next() {
+		var reason domain.DeclineReason
+		var count int
+		if err := rows.Scan(&reason, &count); err != nil {
+			return nil, fmt.Errorf("scan decline reason: %w", err)
+		}
+		counts[reason] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate decline reasons: %w", err)
+	}
+
+	return counts, nil
+}
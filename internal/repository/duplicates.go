@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) SetUniqueOpenPRNames(ctx context.Context, tx pgx.Tx, teamID int64, enabled bool) ([]domain.PullRequestNameConflict, error) {
+	if tx == nil {
+		return nil, errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE teams SET unique_open_pr_names = $2 WHERE team_id = $1
+	`, teamID, enabled); err != nil {
+		return nil, fmt.Errorf("update unique open pr names: %w", err)
+	}
+
+	if !enabled {
+		if _, err := tx.Exec(ctx, `
+			UPDATE pull_requests pr
+			SET enforce_unique_name = FALSE
+			FROM team_memberships tm
+			WHERE tm.team_id = $1
+			  AND tm.user_id = pr.author_id
+			  AND pr.status_id = $2
+			  AND pr.enforce_unique_name
+		`, teamID, prStatusOpenID); err != nil {
+			return nil, fmt.Errorf("clear enforce unique name: %w", err)
+		}
+		return nil, nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		WITH ranked AS (
+			SELECT pr.pull_request_id, pr.author_id, pr.pull_request_name, pr.enforce_unique_name,
+			       row_number() OVER (
+			           PARTITION BY pr.author_id, pr.pull_request_name
+			           ORDER BY pr.enforce_unique_name DESC, pr.created_at, pr.pull_request_id
+			       ) AS rn
+			FROM pull_requests pr
+			JOIN team_memberships tm ON tm.user_id = pr.author_id AND tm.team_id = $1
+			WHERE pr.status_id = $2
+			  AND pr.deleted_at IS NULL
+		),
+		enforced AS (
+			UPDATE pull_requests pr
+			SET enforce_unique_name = TRUE
+			FROM ranked r
+			WHERE r.pull_request_id = pr.pull_request_id
+			  AND r.rn = 1
+			  AND NOT r.enforce_unique_name
+		)
+		SELECT author_id, pull_request_name,
+		       (array_agg(pull_request_id ORDER BY rn))[1],
+		       (array_agg(pull_request_id ORDER BY rn))[2:]
+		FROM ranked
+		GROUP BY author_id, pull_request_name
+		HAVING count(*) > 1
+		ORDER BY author_id, pull_request_name
+	`, teamID, prStatusOpenID)
+	if err != nil {
+		return nil, fmt.Errorf("backfill enforce unique name: %w", err)
+	}
+	defer rows.Close()
+
+	conflicts := make([]domain.PullRequestNameConflict, 0)
+	for rows.Next() {
+		var c domain.PullRequestNameConflict
+		if err := rows.Scan(&c.AuthorID, &c.PullRequestName, &c.EnforcedID, &c.DuplicateIDs); err != nil {
+			return nil, fmt.Errorf("scan pull request name conflict: %w", err)
+		}
+		conflicts = append(conflicts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate pull request name conflicts: %w", err)
+	}
+
+	return conflicts, nil
+}
+
+func (r *Repository) FindOpenPullRequestByName(ctx context.Context, authorID, name string) (string, error) {
+	var prID string
+	err := r.pool.QueryRow(ctx, `
+		SELECT pull_request_id
+		FROM pull_requests
+		WHERE author_id = $1
+		  AND pull_request_name = $2
+		  AND status_id = $3
+		  AND deleted_at IS NULL
+		ORDER BY created_at
+		LIMIT 1
+	`, authorID, name, prStatusOpenID).Scan(&prID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrPullRequestNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("select open pull request by name: %w", err)
+	}
+
+	return prID, nil
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) ListReviewerExclusions(ctx context.Context) ([]domain.ReviewerExclusion, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT user_a, user_b, reason, created_at
+		FROM reviewer_exclusions
+		ORDER BY user_a, user_b
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("select reviewer exclusions: %w", err)
+	}
+	defer rows.Close()
+
+	exclusions := []domain.ReviewerExclusion{}
+	for rows.Next() {
+		var e domain.ReviewerExclusion
+		if err := rows.Scan(&e.UserA, &e.UserB, &e.Reason, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan reviewer exclusion: %w", err)
+		}
+		exclusions = append(exclusions, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reviewer exclusions: %w", err)
+	}
+
+	return exclusions, nil
+}
+
+func (r *Repository) ListExcludedReviewers(ctx context.Context, userID string) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT user_b FROM reviewer_exclusions WHERE user_a = $1
+		UNION
+		SELECT user_a FROM reviewer_exclusions WHERE user_b = $1
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("select excluded reviewers: %w", err)
+	}
+
+	return collectUserIDs(rows, "excluded reviewer")
+}
+
+func (r *Repository) GetUserWithExclusions(ctx context.Context, userID string) (domain.User, []string, error) {
+	var user domain.User
+	var teamID sql.NullInt64
+	var teamName sql.NullString
+	var excluded []string
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT u.user_id, u.username, u.is_active, u.seniority, u.version, tm.team_id, t.team_name,
+		       ARRAY(
+		           SELECT user_b FROM reviewer_exclusions WHERE user_a = u.user_id
+		           UNION
+		           SELECT user_a FROM reviewer_exclusions WHERE user_b = u.user_id
+		       )
+		FROM users u
+		LEFT JOIN team_memberships tm ON tm.user_id = u.user_id
+		LEFT JOIN teams t ON t.team_id = tm.team_id
+		WHERE u.user_id = $1
+	`, userID).Scan(&user.ID, r.openText(&user.Username), &user.IsActive, &user.Seniority, &user.Version, &teamID, &teamName, &excluded)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.User{}, nil, ErrUserNotFound
+	}
+	if err != nil {
+		return domain.User{}, nil, fmt.Errorf("select user with exclusions: %w", err)
+	}
+
+	if teamID.Valid {
+		id := teamID.Int64
+		user.TeamID = &id
+	}
+	if teamName.Valid {
+		name := teamName.String
+		user.TeamName = &name
+	}
+
+	return user, excluded, nil
+}
+
+func (r *Repository) UpsertReviewerExclusion(ctx context.Context, tx pgx.Tx, e domain.ReviewerExclusion) (domain.ReviewerExclusion, error) {
+	if tx == nil {
+		return domain.ReviewerExclusion{}, errTxRequired
+	}
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO reviewer_exclusions (user_a, user_b, reason)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_a, user_b) DO UPDATE SET reason = EXCLUDED.reason
+		RETURNING created_at
+	`, e.UserA, e.UserB, e.Reason).Scan(&e.CreatedAt)
+	if err != nil {
+		if isConstraintViolation(err, "reviewer_exclusions_user_a_fkey") || isConstraintViolation(err, "reviewer_exclusions_user_b_fkey") {
+			return domain.ReviewerExclusion{}, ErrUserNotFound
+		}
+		return domain.ReviewerExclusion{}, fmt.Errorf("upsert reviewer exclusion: %w", err)
+	}
+
+	return e, nil
+}
+
+func (r *Repository) DeleteReviewerExclusion(ctx context.Context, tx pgx.Tx, userA, userB string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM reviewer_exclusions WHERE user_a = $1 AND user_b = $2`, userA, userB)
+	if err != nil {
+		return fmt.Errorf("delete reviewer exclusion: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrExclusionNotFound
+	}
+
+	return nil
+}
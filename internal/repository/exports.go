@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) NextHistoryExportDate(ctx context.Context, kind string) (*time.Time, error) {
+	var next sql.NullTime
+	if err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(
+		    (SELECT MAX(partition_date) + 1 FROM history_exports WHERE kind = $1),
+		    (SELECT (MIN(assigned_at) AT TIME ZONE 'UTC')::date FROM pr_reviewers)
+		)::timestamp
+	`, kind).Scan(&next); err != nil {
+		return nil, fmt.Errorf("select next history export date: %w", err)
+	}
+	if !next.Valid {
+		return nil, nil
+	}
+	t := time.Date(next.Time.Year(), next.Time.Month(), next.Time.Day(), 0, 0, 0, 0, time.UTC)
+	return &t, nil
+}
+
+func (r *Repository) ListReviewerHistory(ctx context.Context, from, to time.Time) ([]domain.ReviewerHistoryRecord, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT rr.pull_request_id, pr.author_id, rr.reviewer_id, rr.kind, rr.reassigned,
+		       COALESCE(rr.handoff_from, ''), rr.assigned_at, rr.first_response_at
+		FROM pr_reviewers rr
+		JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+		WHERE rr.assigned_at >= $1 AND rr.assigned_at < $2
+		ORDER BY rr.assigned_at, rr.pull_request_id, rr.reviewer_id
+	`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("select reviewer history: %w", err)
+	}
+	defer rows.Close()
+
+	records := []domain.ReviewerHistoryRecord{}
+	for rows.Next() {
+		var rec domain.ReviewerHistoryRecord
+		var kind string
+		var firstResponseAt sql.NullTime
+		if err := rows.Scan(&rec.PullRequestID, &rec.AuthorID, &rec.ReviewerID, &kind, &rec.Reassigned,
+			&rec.HandoffFrom, &rec.AssignedAt, &firstResponseAt); err != nil {
+			return nil, fmt.Errorf("scan reviewer history: %w", err)
+		}
+		rec.Kind = domain.AssignmentKind(kind)
+		if firstResponseAt.Valid {
+			t := firstResponseAt.Time
+			rec.FirstResponseAt = &t
+		}
+		records = append(records, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reviewer history: %w", err)
+	}
+
+	return records, nil
+}
+
+func (r *Repository) RecordHistoryExport(ctx context.Context, tx pgx.Tx, export domain.HistoryExport) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO history_exports (kind, partition_date, object_key, row_count, byte_count, exported_at)
+		VALUES ($1, $2::date, $3, $4, $5, $6)
+		ON CONFLICT (kind, partition_date)
+		DO UPDATE SET object_key = EXCLUDED.object_key,
+		              row_count = EXCLUDED.row_count,
+		              byte_count = EXCLUDED.byte_count,
+		              exported_at = EXCLUDED.exported_at
+	`, export.Kind, export.Date, export.ObjectKey, export.Rows, export.Bytes, export.ExportedAt); err != nil {
+		return fmt.Errorf("insert history export: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListHistoryExports(ctx context.Context) ([]domain.HistoryExport, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT kind, partition_date, object_key, row_count, byte_count, exported_at
+		FROM history_exports
+		ORDER BY kind, partition_date
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("select history exports: %w", err)
+	}
+	defer rows.Close()
+
+	exports := []domain.HistoryExport{}
+	for rows.Next() {
+		var e domain.HistoryExport
+		if err := rows.Scan(&e.Kind, &e.Date, &e.ObjectKey, &e.Rows, &e.Bytes, &e.ExportedAt); err != nil {
+			return nil, fmt.Errorf("scan history export: %w", err)
+		}
+		exports = append(exports, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate history exports: %w", err)
+	}
+
+	return exports, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) GetFocusSchedule(ctx context.Context, userID string) (domain.FocusSchedule, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT timezone, start_minutes, end_minutes
+		FROM user_focus_windows
+		WHERE user_id = $1
+		ORDER BY start_minutes
+	`, userID)
+	if err != nil {
+		return domain.FocusSchedule{}, fmt.Errorf("select focus windows: %w", err)
+	}
+	defer rows.Close()
+
+	schedule := domain.FocusSchedule{UserID: userID}
+	for rows.Next() {
+		var startMinutes, endMinutes int
+		if err := rows.Scan(&schedule.Timezone, &startMinutes, &endMinutes); err != nil {
+			return domain.FocusSchedule{}, fmt.Errorf("scan focus window: %w", err)
+		}
+		schedule.Windows = append(schedule.Windows, domain.FocusWindow{
+			Start: time.Duration(startMinutes) * time.Minute,
+			End:   time.Duration(endMinutes) * time.Minute,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return domain.FocusSchedule{}, fmt.Errorf("iterate focus windows: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (r *Repository) ReplaceFocusSchedule(ctx context.Context, tx pgx.Tx, schedule domain.FocusSchedule) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM user_focus_windows WHERE user_id = $1`, schedule.UserID); err != nil {
+		return fmt.Errorf("delete focus windows: %w", err)
+	}
+
+	for _, w := range schedule.Windows {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO user_focus_windows (user_id, timezone, start_minutes, end_minutes)
+			VALUES ($1, $2, $3, $4)
+		`, schedule.UserID, schedule.Timezone, int(w.Start/time.Minute), int(w.End/time.Minute)); err != nil {
+			if isConstraintViolation(err, "user_focus_windows_user_id_fkey") {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("insert focus window: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (r *Repository) ListReviewerLoad(ctx context.Context, teamID int64, since, now time.Time) ([]domain.ReviewerLoad, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.user_id,
+		       u.username,
+		       u.is_active,
+		       COUNT(rr.pull_request_id) FILTER (
+		           WHERE pr.status_id = $2 AND (rr.first_response_at IS NULL OR rr.approval_reset_at IS NOT NULL)
+		       ),
+		       COUNT(rr.pull_request_id) FILTER (
+		           WHERE rr.first_response_at >= $3
+		       ),
+		       COUNT(rr.pull_request_id) FILTER (
+		           WHERE pr.status_id = $2 AND (rr.first_response_at IS NULL OR rr.approval_reset_at IS NOT NULL) AND pr.review_due_at < $4
+		       )
+		FROM team_memberships tm
+		JOIN users u ON u.user_id = tm.user_id
+		LEFT JOIN (
+		    pr_reviewers rr
+		    JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id AND pr.deleted_at IS NULL
+		) ON rr.reviewer_id = u.user_id AND rr.kind = 'regular'
+		WHERE tm.team_id = $1
+		GROUP BY u.user_id, u.username, u.is_active
+		ORDER BY u.user_id
+	`, teamID, prStatusOpenID, since, now)
+	if err != nil {
+		return nil, fmt.Errorf("select reviewer load: %w", err)
+	}
+	defer rows.Close()
+
+	var loads []domain.ReviewerLoad
+	for rows.Next() {
+		var l domain.ReviewerLoad
+		if err := rows.Scan(&l.UserID, r.openText(&l.Username), &l.IsActive, &l.PendingReviews, &l.HandledReviews, &l.OverdueReviews); err != nil {
+			return nil, fmt.Errorf("scan reviewer load: %w", err)
+		}
+		loads = append(loads, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reviewer load: %w", err)
+	}
+
+	return loads, nil
+}
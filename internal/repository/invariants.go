@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (r *Repository) CountInvariantViolations(ctx context.Context) (domain.InvariantGauges, error) {
+	var g domain.InvariantGauges
+	if err := r.pool.QueryRow(ctx, `
+		SELECT
+			(SELECT COUNT(*)
+			 FROM teams t
+			 WHERE NOT EXISTS (
+			     SELECT 1
+			     FROM team_memberships tm
+			     JOIN users u ON u.user_id = tm.user_id
+			     WHERE tm.team_id = t.team_id AND u.is_active
+			 )),
+			(SELECT COUNT(*)
+			 FROM pull_requests pr
+			 JOIN team_memberships tm ON tm.user_id = pr.author_id
+			 JOIN (
+			     SELECT team_id, SUM(min_reviewers) AS required
+			     FROM team_quorum_rules
+			     GROUP BY team_id
+			 ) q ON q.team_id = tm.team_id
+			 WHERE pr.status_id = $1
+			   AND pr.deleted_at IS NULL
+			   AND pr.assignment_deferred_at IS NULL
+			   AND (
+			       SELECT COUNT(*)
+			       FROM pr_reviewers rr
+			       WHERE rr.pull_request_id = pr.pull_request_id AND rr.kind = 'regular'
+			   ) < q.required),
+			(SELECT COUNT(DISTINCT rr.reviewer_id)
+			 FROM pr_reviewers rr
+			 JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+			 JOIN users u ON u.user_id = rr.reviewer_id
+			 WHERE pr.status_id = $1 AND pr.deleted_at IS NULL AND NOT u.is_active)
+	`, prStatusOpenID).Scan(&g.TeamsWithoutActiveMembers, &g.UnderstaffedPullRequests, &g.InactiveAssignedReviewers); err != nil {
+		return domain.InvariantGauges{}, fmt.Errorf("count invariant violations: %w", err)
+	}
+	return g, nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) ListLeaderboard(ctx context.Context, teamID int64, since *time.Time) ([]domain.LeaderboardEntry, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.user_id,
+		       u.username,
+		       COUNT(rr.pull_request_id) FILTER (
+		           WHERE pr.status_id = $3 AND ($2::timestamptz IS NULL OR pr.merged_at >= $2)
+		       ),
+		       COALESCE(percentile_cont(0.5) WITHIN GROUP (
+		           ORDER BY EXTRACT(EPOCH FROM rr.first_response_at - rr.assigned_at)
+		       ) FILTER (
+		           WHERE rr.first_response_at IS NOT NULL AND ($2::timestamptz IS NULL OR rr.assigned_at >= $2)
+		       ), 0),
+		       COUNT(rr.pull_request_id) FILTER (
+		           WHERE rr.reassigned AND ($2::timestamptz IS NULL OR rr.assigned_at >= $2)
+		       )
+		FROM team_memberships tm
+		JOIN users u ON u.user_id = tm.user_id
+		LEFT JOIN (
+		    pr_reviewers rr
+		    JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id AND pr.deleted_at IS NULL
+		) ON rr.reviewer_id = u.user_id AND rr.kind = 'regular'
+		WHERE tm.team_id = $1
+		  AND NOT u.leaderboard_opt_out
+		GROUP BY u.user_id, u.username
+	`, teamID, since, prStatusMergedID)
+	if err != nil {
+		return nil, fmt.Errorf("select leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []domain.LeaderboardEntry
+	for rows.Next() {
+		var e domain.LeaderboardEntry
+		var medianSeconds float64
+		if err := rows.Scan(&e.UserID, r.openText(&e.Username), &e.CompletedReviews, &medianSeconds, &e.Saves); err != nil {
+			return nil, fmt.Errorf("scan leaderboard entry: %w", err)
+		}
+		e.MedianFirstResponse = time.Duration(medianSeconds * float64(time.Second))
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate leaderboard: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (r *Repository) SetLeaderboardOptOut(ctx context.Context, userID string, optOut bool) error {
+	var stored string
+	if err := r.pool.QueryRow(ctx, `
+		UPDATE users
+		SET leaderboard_opt_out = $2,
+		    updated_at = NOW()
+		WHERE user_id = $1
+		RETURNING user_id
+	`, userID, optOut).Scan(&stored); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("update leaderboard opt-out: %w", err)
+	}
+
+	return nil
+}
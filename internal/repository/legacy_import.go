@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) ImportPullRequest(ctx context.Context, tx pgx.Tx, pr domain.PullRequest) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	statusID := prStatusOpenID
+	if pr.Status == domain.PullRequestStatusMerged {
+		statusID = prStatusMergedID
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO pull_requests (pull_request_id, pull_request_name, title, author_id, status_id, created_at, merged_at, enforce_unique_name)
+		VALUES ($1, $2, $2, $3, $4, $5, $6, (
+			SELECT COALESCE(bool_or(t.unique_open_pr_names), FALSE)
+			FROM team_memberships tm
+			JOIN teams t ON t.team_id = tm.team_id
+			WHERE tm.user_id = $3
+		))
+	`, pr.ID, pr.Name, pr.AuthorID, statusID, pr.CreatedAt, pr.MergedAt); err != nil {
+		if isConstraintViolation(err, "idx_pull_requests_open_name_unique") {
+			return ErrDuplicatePullRequest
+		}
+		if isUniqueViolation(err) {
+			return ErrPullRequestExists
+		}
+		return fmt.Errorf("insert pull request: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ImportReviewer(ctx context.Context, tx pgx.Tx, prID, reviewerID string, assignedAt time.Time) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO pr_reviewers (pull_request_id, reviewer_id, assigned_at)
+		VALUES ($1, $2, $3)
+	`, prID, reviewerID, assignedAt); err != nil {
+		return fmt.Errorf("insert reviewer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ImportUser(ctx context.Context, tx pgx.Tx, user domain.User) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	username, err := r.sealText(user.Username)
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO users (user_id, username, is_active, seniority)
+		VALUES ($1, $2, $3, COALESCE(NULLIF($4, ''), 'middle'))
+		ON CONFLICT (user_id) DO NOTHING
+	`, user.ID, username, user.IsActive, string(user.Seniority))
+	if err != nil {
+		return fmt.Errorf("insert imported user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserExists
+	}
+
+	return nil
+}
+
+func (r *Repository) ListExistingUserTeams(ctx context.Context, userIDs []string) (map[string]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.user_id, COALESCE(t.team_name, '')
+		FROM users u
+		LEFT JOIN team_memberships tm ON tm.user_id = u.user_id
+		LEFT JOIN teams t ON t.team_id = tm.team_id
+		WHERE u.user_id = ANY($1)
+	`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("select existing users: %w", err)
+	}
+	defer rows.Close()
+
+	teams := make(map[string]string)
+	for rows.Next() {
+		var userID, teamName string
+		if err := rows.Scan(&userID, &teamName); err != nil {
+			return nil, fmt.Errorf("scan existing user: %w", err)
+		}
+		teams[userID] = teamName
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate existing users: %w", err)
+	}
+	return teams, nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) LinkPullRequests(ctx context.Context, tx pgx.Tx, prID, blockedByID string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('pr_links'))`); err != nil {
+		return fmt.Errorf("lock pr links: %w", err)
+	}
+
+	var cycle bool
+	if err := tx.QueryRow(ctx, `
+		WITH RECURSIVE deps AS (
+			SELECT blocked_by_id
+			FROM pr_links
+			WHERE pull_request_id = $1
+			UNION
+			SELECT l.blocked_by_id
+			FROM pr_links l
+			JOIN deps d ON l.pull_request_id = d.blocked_by_id
+		)
+		SELECT $1 = $2 OR EXISTS (SELECT 1 FROM deps WHERE blocked_by_id = $2)
+	`, blockedByID, prID).Scan(&cycle); err != nil {
+		return fmt.Errorf("check pr link cycle: %w", err)
+	}
+	if cycle {
+		return ErrDependencyCycle
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO pr_links (pull_request_id, blocked_by_id)
+		VALUES ($1, $2)
+		ON CONFLICT (pull_request_id, blocked_by_id) DO NOTHING
+	`, prID, blockedByID); err != nil {
+		return fmt.Errorf("insert pr link: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) UnlinkPullRequests(ctx context.Context, tx pgx.Tx, prID, blockedByID string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM pr_links
+		WHERE pull_request_id = $1 AND blocked_by_id = $2
+	`, prID, blockedByID); err != nil {
+		return fmt.Errorf("delete pr link: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) listBlockers(ctx context.Context, q querier, prID string) ([]domain.PullRequestShort, error) {
+	rows, err := q.Query(ctx, `
+		SELECT pr.pull_request_id,
+		       `+r.prName()+`,
+		       pr.author_id,
+		       s.code
+		FROM pr_links l
+		JOIN pull_requests pr ON pr.pull_request_id = l.blocked_by_id
+		JOIN pull_request_statuses s ON s.status_id = pr.status_id
+		WHERE l.pull_request_id = $1 AND pr.deleted_at IS NULL
+		ORDER BY l.created_at
+	`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("select blockers: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.PullRequestShort
+	for rows.Next() {
+		var pr domain.PullRequestShort
+		var status string
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status); err != nil {
+			return nil, fmt.Errorf("scan blocker: %w", err)
+		}
+		pr.Status = domain.PullRequestStatus(status)
+		result = append(result, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate blockers: %w", err)
+	}
+
+	return result, nil
+}
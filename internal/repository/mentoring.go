@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) SetMentoringShadows(ctx context.Context, tx pgx.Tx, teamID int64, enabled bool) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE teams SET mentoring_shadows = $2 WHERE team_id = $1
+	`, teamID, enabled); err != nil {
+		return fmt.Errorf("update mentoring shadows: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) IsMentoringEnabled(ctx context.Context, teamID int64) (bool, error) {
+	var enabled bool
+	if err := r.pool.QueryRow(ctx, `
+		SELECT mentoring_shadows FROM teams WHERE team_id = $1
+	`, teamID).Scan(&enabled); err != nil {
+		return false, fmt.Errorf("select mentoring shadows: %w", err)
+	}
+	return enabled, nil
+}
+
+func (r *Repository) ListRandomMentees(ctx context.Context, teamID int64, exclude []string, limit int) ([]domain.TeamMember, error) {
+	if exclude == nil {
+		exclude = []string{}
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.user_id, u.username, u.is_active, u.seniority
+		FROM team_memberships tm
+		JOIN teams t ON t.team_id = tm.team_id
+		JOIN users u ON u.user_id = tm.user_id
+		WHERE tm.team_id = $1
+		  AND u.is_active = TRUE
+		  AND u.user_id <> ALL($2::text[])
+		  AND (u.seniority = $4 OR tm.joined_at + make_interval(days => t.ramp_up_days) > NOW())
+		ORDER BY random()
+		LIMIT $3
+	`, teamID, exclude, limit, string(domain.SeniorityJunior))
+	if err != nil {
+		return nil, fmt.Errorf("select mentees: %w", err)
+	}
+	defer rows.Close()
+
+	var members []domain.TeamMember
+	for rows.Next() {
+		var m domain.TeamMember
+		if err := rows.Scan(&m.UserID, r.openText(&m.Username), &m.IsActive, &m.Seniority); err != nil {
+			return nil, fmt.Errorf("scan mentee: %w", err)
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate mentees: %w", err)
+	}
+
+	return members, nil
+}
+
+func (r *Repository) AddShadowReviewer(ctx context.Context, tx pgx.Tx, prID, reviewerID string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO pr_reviewers (pull_request_id, reviewer_id, kind)
+		VALUES ($1, $2, $3)
+	`, prID, reviewerID, string(domain.AssignmentKindShadow)); err != nil {
+		if isUniqueViolation(err) {
+			return ErrReviewerAlreadyAssigned
+		}
+		return fmt.Errorf("insert shadow reviewer: %w", err)
+	}
+
+	return nil
+}
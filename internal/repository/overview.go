@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+const overviewPullRequestLimit = 200
+
+func (r *Repository) GetAdminOverview(ctx context.Context) (domain.AdminOverview, error) {
+	var overview domain.AdminOverview
+
+	teams, err := r.pool.Query(ctx, `
+		SELECT t.team_name,
+		       COUNT(u.user_id),
+		       COUNT(u.user_id) FILTER (WHERE u.is_active)
+		FROM teams t
+		LEFT JOIN team_memberships tm ON tm.team_id = t.team_id
+		LEFT JOIN users u ON u.user_id = tm.user_id
+		GROUP BY t.team_name
+		ORDER BY t.team_name
+	`)
+	if err != nil {
+		return domain.AdminOverview{}, fmt.Errorf("select team overview: %w", err)
+	}
+	for teams.Next() {
+		var t domain.TeamOverview
+		if err := teams.Scan(&t.TeamName, &t.Members, &t.ActiveMembers); err != nil {
+			teams.Close()
+			return domain.AdminOverview{}, fmt.Errorf("scan team overview: %w", err)
+		}
+		overview.Teams = append(overview.Teams, t)
+	}
+	teams.Close()
+	if err := teams.Err(); err != nil {
+		return domain.AdminOverview{}, fmt.Errorf("iterate team overview: %w", err)
+	}
+
+	prs, err := r.pool.Query(ctx, `
+		SELECT pr.pull_request_id,
+		       `+r.prName()+`,
+		       pr.author_id,
+		       COALESCE(t.team_name, ''),
+		       COALESCE(array_agg(rr.reviewer_id ORDER BY rr.reviewer_id) FILTER (WHERE rr.reviewer_id IS NOT NULL), '{}'),
+		       pr.created_at
+		FROM pull_requests pr
+		LEFT JOIN team_memberships tm ON tm.user_id = pr.author_id
+		LEFT JOIN teams t ON t.team_id = tm.team_id
+		LEFT JOIN pr_reviewers rr ON rr.pull_request_id = pr.pull_request_id AND rr.kind = 'regular'
+		WHERE pr.status_id = $1 AND pr.deleted_at IS NULL
+		GROUP BY pr.pull_request_id, t.team_name
+		ORDER BY pr.created_at DESC
+		LIMIT $2
+	`, prStatusOpenID, overviewPullRequestLimit)
+	if err != nil {
+		return domain.AdminOverview{}, fmt.Errorf("select open pull request overview: %w", err)
+	}
+	for prs.Next() {
+		var pr domain.OpenPullRequestOverview
+		if err := prs.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.TeamName, &pr.Reviewers, &pr.CreatedAt); err != nil {
+			prs.Close()
+			return domain.AdminOverview{}, fmt.Errorf("scan open pull request overview: %w", err)
+		}
+		overview.OpenPullRequests = append(overview.OpenPullRequests, pr)
+	}
+	prs.Close()
+	if err := prs.Err(); err != nil {
+		return domain.AdminOverview{}, fmt.Errorf("iterate open pull request overview: %w", err)
+	}
+
+	reviewers, err := r.pool.Query(ctx, `
+		SELECT u.user_id,
+		       u.username,
+		       t.team_name,
+		       u.is_active,
+		       COALESCE(v.open_reviews, 0) AS open_reviews
+		FROM team_memberships tm
+		JOIN teams t ON t.team_id = tm.team_id
+		JOIN users u ON u.user_id = tm.user_id
+		LEFT JOIN mv_user_open_reviews v ON v.user_id = u.user_id
+		ORDER BY open_reviews DESC, u.user_id
+	`)
+	if err != nil {
+		return domain.AdminOverview{}, fmt.Errorf("select reviewer load overview: %w", err)
+	}
+	defer reviewers.Close()
+	for reviewers.Next() {
+		var rl domain.ReviewerLoadOverview
+		if err := reviewers.Scan(&rl.UserID, r.openText(&rl.Username), &rl.TeamName, &rl.IsActive, &rl.OpenReviews); err != nil {
+			return domain.AdminOverview{}, fmt.Errorf("scan reviewer load overview: %w", err)
+		}
+		overview.Reviewers = append(overview.Reviewers, rl)
+	}
+	if err := reviewers.Err(); err != nil {
+		return domain.AdminOverview{}, fmt.Errorf("iterate reviewer load overview: %w", err)
+	}
+
+	if overview.ReviewersRefreshedAt, err = r.getStatsViewRefreshedAt(ctx, "mv_user_open_reviews"); err != nil {
+		return domain.AdminOverview{}, err
+	}
+
+	return overview, nil
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func pageClause(page domain.Page, columns map[string]string, tiebreaker string) (string, error) {
+	column, ok := columns[page.SortBy]
+	if !ok {
+		return "", fmt.Errorf("unsupported sort field %q", page.SortBy)
+	}
+
+	direction := "ASC"
+	if page.Desc {
+		direction = "DESC"
+	}
+
+	clause := fmt.Sprintf("ORDER BY %s %s, %s %s", column, direction, tiebreaker, direction)
+	if page.Limit > 0 {
+		clause += fmt.Sprintf(" LIMIT %d", page.Limit)
+	}
+	if page.Offset > 0 {
+		clause += fmt.Sprintf(" OFFSET %d", page.Offset)
+	}
+
+	return clause, nil
+}
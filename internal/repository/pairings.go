@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (r *Repository) ListPairings(ctx context.Context, teamID int64, since *time.Time) ([]domain.PairingEdge, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT pr.author_id, rr.reviewer_id, COUNT(*)
+		FROM pull_requests pr
+		JOIN team_memberships tm ON tm.user_id = pr.author_id AND tm.team_id = $1
+		JOIN pr_reviewers rr ON rr.pull_request_id = pr.pull_request_id AND rr.kind = 'regular'
+		WHERE pr.deleted_at IS NULL
+		  AND ($2::TIMESTAMPTZ IS NULL OR rr.assigned_at >= $2)
+		GROUP BY pr.author_id, rr.reviewer_id
+		ORDER BY COUNT(*) DESC, pr.author_id, rr.reviewer_id
+	`, teamID, since)
+	if err != nil {
+		return nil, fmt.Errorf("select pairings: %w", err)
+	}
+	defer rows.Close()
+
+	var edges []domain.PairingEdge
+	for rows.Next() {
+		var e domain.PairingEdge
+		if err := rows.Scan(&e.AuthorID, &e.ReviewerID, &e.Reviews); err != nil {
+			return nil, fmt.Errorf("scan pairing: %w", err)
+		}
+		edges = append(edges, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate pairings: %w", err)
+	}
+
+	return edges, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/fieldcrypt"
+	"github.com/jackc/pgx/v5"
+)
+
+var errNoFieldKeys = errors.New("field encryption keys are not configured")
+
+type sealedText struct {
+	dst  *string
+	keys *fieldcrypt.Keyring
+}
+
+func (s *sealedText) Scan(src any) error {
+	var value string
+	switch v := src.(type) {
+	case nil:
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("scan sealed text: unsupported type %T", src)
+	}
+
+	plain, err := s.keys.Decrypt(value)
+	if err != nil {
+		return fmt.Errorf("decrypt field: %w", err)
+	}
+	*s.dst = plain
+	return nil
+}
+
+func (r *Repository) openText(dst *string) *sealedText {
+	return &sealedText{dst: dst, keys: r.fields}
+}
+
+func (r *Repository) sealText(value string) (string, error) {
+	sealed, err := r.fields.Encrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("encrypt field: %w", err)
+	}
+	return sealed, nil
+}
+
+func (r *Repository) ReencryptUsernames(ctx context.Context, batchSize int) (int, error) {
+	if r.fields == nil {
+		return 0, errNoFieldKeys
+	}
+
+	rewritten := 0
+	after := ""
+	for {
+		rows, err := r.pool.Query(ctx, `
+			SELECT user_id, username
+			FROM users
+			WHERE user_id > $1
+			ORDER BY user_id
+			LIMIT $2
+		`, after, batchSize)
+		if err != nil {
+			return rewritten, fmt.Errorf("select usernames: %w", err)
+		}
+		type stored struct{ userID, username string }
+		var batch []stored
+		for rows.Next() {
+			var s stored
+			if err := rows.Scan(&s.userID, &s.username); err != nil {
+				rows.Close()
+				return rewritten, fmt.Errorf("scan username: %w", err)
+			}
+			batch = append(batch, s)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return rewritten, fmt.Errorf("iterate usernames: %w", err)
+		}
+		if len(batch) == 0 {
+			return rewritten, nil
+		}
+		after = batch[len(batch)-1].userID
+
+		err = r.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+			for _, s := range batch {
+				if !r.fields.NeedsRewrap(s.username) {
+					continue
+				}
+				plain, err := r.fields.Decrypt(s.username)
+				if err != nil {
+					return fmt.Errorf("decrypt username of %s: %w", s.userID, err)
+				}
+				sealed, err := r.sealText(plain)
+				if err != nil {
+					return err
+				}
+				tag, err := tx.Exec(ctx, `
+					UPDATE users SET username = $3
+					WHERE user_id = $1 AND username = $2
+				`, s.userID, s.username, sealed)
+				if err != nil {
+					return fmt.Errorf("update username: %w", err)
+				}
+				rewritten += int(tag.RowsAffected())
+			}
+			return nil
+		})
+		if err != nil {
+			return rewritten, err
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+var policyConflictTargets = map[domain.PolicySource]string{
+	domain.PolicySourceOrg:         "(key) WHERE scope = 'org'",
+	domain.PolicySourceTeam:        "(team_id, key) WHERE scope = 'team'",
+	domain.PolicySourcePullRequest: "(pull_request_id, key) WHERE scope = 'pull_request'",
+}
+
+func (r *Repository) ListPolicyOverrides(ctx context.Context, teamID int64, prID string) ([]domain.PolicyOverride, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT o.scope, COALESCE(t.team_name, ''), COALESCE(o.pull_request_id, ''), o.key, o.value, o.updated_at
+		FROM policy_overrides o
+		LEFT JOIN teams t ON t.team_id = o.team_id
+		WHERE o.scope = 'org'
+		   OR (o.scope = 'team' AND o.team_id = $1)
+		   OR (o.scope = 'pull_request' AND o.pull_request_id = $2)
+		ORDER BY o.key, o.scope
+	`, teamID, prID)
+	if err != nil {
+		return nil, fmt.Errorf("select policy overrides: %w", err)
+	}
+	defer rows.Close()
+
+	var overrides []domain.PolicyOverride
+	for rows.Next() {
+		var o domain.PolicyOverride
+		var scope, key string
+		if err := rows.Scan(&scope, &o.TeamName, &o.PullRequestID, &key, &o.Value, &o.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan policy override: %w", err)
+		}
+		o.Scope, o.Key = domain.PolicySource(scope), domain.PolicyKey(key)
+		overrides = append(overrides, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate policy overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+func (r *Repository) UpsertPolicyOverride(ctx context.Context, tx pgx.Tx, o domain.PolicyOverride, teamID *int64, at time.Time) error {
+	if tx == nil {
+		return errTxRequired
+	}
+	target, ok := policyConflictTargets[o.Scope]
+	if !ok {
+		return fmt.Errorf("unsupported policy scope %q", o.Scope)
+	}
+
+	var prID *string
+	if o.Scope == domain.PolicySourcePullRequest {
+		prID = &o.PullRequestID
+	}
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO policy_overrides (scope, team_id, pull_request_id, key, value, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT `+target+` DO UPDATE
+		SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+	`, string(o.Scope), teamID, prID, string(o.Key), o.Value, at); err != nil {
+		if isConstraintViolation(err, "policy_overrides_pull_request_id_fkey") {
+			return ErrPullRequestNotFound
+		}
+		return fmt.Errorf("upsert policy override: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) DeletePolicyOverride(ctx context.Context, tx pgx.Tx, scope domain.PolicySource, teamID *int64, prID string, key domain.PolicyKey) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM policy_overrides
+		WHERE scope = $1
+		  AND team_id IS NOT DISTINCT FROM $2
+		  AND COALESCE(pull_request_id, '') = $3
+		  AND key = $4
+	`, string(scope), teamID, prID, string(key)); err != nil {
+		return fmt.Errorf("delete policy override: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ErrPoolExhausted = errors.New("database connection pool exhausted")
+
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type timedPool struct {
+	*pgxpool.Pool
+	readPool       *pgxpool.Pool
+	acquireTimeout time.Duration
+}
+
+func (p *timedPool) acquire(ctx context.Context) (*pgxpool.Conn, error) {
+	return p.acquireFrom(ctx, p.Pool)
+}
+
+func (p *timedPool) acquireRead(ctx context.Context) (*pgxpool.Conn, error) {
+	if p.readPool != nil && ctxutil.ReplicaReads(ctx) {
+		return p.acquireFrom(ctx, p.readPool)
+	}
+	return p.acquireFrom(ctx, p.Pool)
+}
+
+func (p *timedPool) acquireFrom(ctx context.Context, pool *pgxpool.Pool) (*pgxpool.Conn, error) {
+	acquireCtx := ctx
+	if p.acquireTimeout > 0 {
+		var cancel context.CancelFunc
+		acquireCtx, cancel = context.WithTimeout(ctx, p.acquireTimeout)
+		defer cancel()
+	}
+
+	conn, err := pool.Acquire(acquireCtx)
+	if err != nil {
+		if ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("%w: no connection within %s", ErrPoolExhausted, p.acquireTimeout)
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (p *timedPool) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	conn, err := p.acquire(ctx)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	defer conn.Release()
+
+	defer recordStatement(ctx, time.Now(), 0)
+	return conn.Exec(ctx, sql, args...)
+}
+
+func (p *timedPool) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	conn, err := p.acquireRead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		recordStatement(ctx, start, 0)
+		conn.Release()
+		return nil, err
+	}
+	return &connRows{Rows: rows, conn: conn, ctx: ctx, start: start}, nil
+}
+
+func (p *timedPool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	conn, err := p.acquireRead(ctx)
+	if err != nil {
+		return errRow{err: err}
+	}
+	start := time.Now()
+	return &connRow{row: conn.QueryRow(ctx, sql, args...), conn: conn, ctx: ctx, start: start}
+}
+
+func (p *timedPool) BeginTx(ctx context.Context, opts pgx.TxOptions) (pgx.Tx, error) {
+	conn, err := p.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	tx, err := conn.BeginTx(ctx, opts)
+	recordStatement(ctx, start, 0)
+	if err != nil {
+		conn.Release()
+		return nil, err
+	}
+	return &connTx{Tx: tx, conn: conn}, nil
+}
+
+func recordStatement(ctx context.Context, start time.Time, rows int64) {
+	ctxutil.DBSessionFrom(ctx).Record(time.Since(start), rows)
+}
+
+type connRows struct {
+	pgx.Rows
+	conn   *pgxpool.Conn
+	ctx    context.Context
+	start  time.Time
+	read   int64
+	closed bool
+}
+
+func (r *connRows) Next() bool {
+	if r.Rows.Next() {
+		r.read++
+		return true
+	}
+	return false
+}
+
+func (r *connRows) Close() {
+	r.Rows.Close()
+	if !r.closed {
+		r.closed = true
+		recordStatement(r.ctx, r.start, r.read)
+	}
+	if r.conn != nil {
+		r.conn.Release()
+		r.conn = nil
+	}
+}
+
+type connRow struct {
+	row   pgx.Row
+	conn  *pgxpool.Conn
+	ctx   context.Context
+	start time.Time
+}
+
+func (r *connRow) Scan(dest ...any) error {
+	if r.conn != nil {
+		defer r.conn.Release()
+	}
+	err := r.row.Scan(dest...)
+	var rows int64
+	if err == nil {
+		rows = 1
+	}
+	recordStatement(r.ctx, r.start, rows)
+	return err
+}
+
+type errRow struct {
+	err error
+}
+
+func (r errRow) Scan(...any) error {
+	return r.err
+}
+
+type connTx struct {
+	pgx.Tx
+	conn *pgxpool.Conn
+}
+
+func (t *connTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	defer recordStatement(ctx, time.Now(), 0)
+	return t.Tx.Exec(ctx, sql, args...)
+}
+
+func (t *connTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	start := time.Now()
+	rows, err := t.Tx.Query(ctx, sql, args...)
+	if err != nil {
+		recordStatement(ctx, start, 0)
+		return nil, err
+	}
+	return &connRows{Rows: rows, ctx: ctx, start: start}, nil
+}
+
+func (t *connTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	start := time.Now()
+	return &connRow{row: t.Tx.QueryRow(ctx, sql, args...), ctx: ctx, start: start}
+}
+
+func (t *connTx) Commit(ctx context.Context) error {
+	defer t.release()
+	defer recordStatement(ctx, time.Now(), 0)
+	return t.Tx.Commit(ctx)
+}
+
+func (t *connTx) Rollback(ctx context.Context) error {
+	defer t.release()
+	defer recordStatement(ctx, time.Now(), 0)
+	return t.Tx.Rollback(ctx)
+}
+
+func (t *connTx) release() {
+	if t.conn != nil {
+		t.conn.Release()
+		t.conn = nil
+	}
+}
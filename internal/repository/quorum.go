@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) ListQuorumRules(ctx context.Context, teamID int64) ([]domain.QuorumRule, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT seniority, min_reviewers
+		FROM team_quorum_rules
+		WHERE team_id = $1
+		ORDER BY seniority
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("select quorum rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []domain.QuorumRule
+	for rows.Next() {
+		var rule domain.QuorumRule
+		if err := rows.Scan(&rule.Seniority, &rule.MinReviewers); err != nil {
+			return nil, fmt.Errorf("scan quorum rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate quorum rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *Repository) ReplaceQuorumRules(ctx context.Context, tx pgx.Tx, teamID int64, rules []domain.QuorumRule) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM team_quorum_rules WHERE team_id = $1`, teamID); err != nil {
+		return fmt.Errorf("delete quorum rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO team_quorum_rules (team_id, seniority, min_reviewers)
+			VALUES ($1, $2, $3)
+		`, teamID, string(rule.Seniority), rule.MinReviewers); err != nil {
+			return fmt.Errorf("insert quorum rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) ListUserSeniorities(ctx context.Context, userIDs []string) (map[string]domain.Seniority, error) {
+	if userIDs == nil {
+		userIDs = []string{}
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT user_id, seniority
+		FROM users
+		WHERE user_id = ANY($1::text[])
+	`, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("select user seniorities: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]domain.Seniority, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var seniority domain.Seniority
+		if err := rows.Scan(&userID, &seniority); err != nil {
+			return nil, fmt.Errorf("scan user seniority: %w", err)
+		}
+		result[userID] = seniority
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate user seniorities: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) SetTeamRampUpDays(ctx context.Context, tx pgx.Tx, teamID int64, days int) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE teams SET ramp_up_days = $2 WHERE team_id = $1
+	`, teamID, days); err != nil {
+		return fmt.Errorf("update ramp up days: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) GetReplicaRole(ctx context.Context, region string) (string, error) {
+	var role string
+	err := r.pool.QueryRow(ctx, `
+		SELECT role FROM replica_roles WHERE region = $1
+	`, region).Scan(&role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("select replica role: %w", err)
+	}
+	return role, nil
+}
+
+func (r *Repository) SetReplicaRole(ctx context.Context, tx pgx.Tx, region, role string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO replica_roles (region, role)
+		VALUES ($1, $2)
+		ON CONFLICT (region) DO UPDATE SET role = EXCLUDED.role
+	`, region, role); err != nil {
+		return fmt.Errorf("upsert replica role: %w", err)
+	}
+
+	return nil
+}
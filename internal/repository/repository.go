@@ -5,9 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/fieldcrypt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -19,26 +23,39 @@ const (
 )
 
 var (
-	ErrTeamExists          = errors.New("team already exists")
-	ErrTeamNotFound        = errors.New("team not found")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrPullRequestExists   = errors.New("pull request already exists")
-	ErrPullRequestNotFound = errors.New("pull request not found")
-	ErrReviewerNotAssigned = errors.New("reviewer not assigned to pull request")
+	ErrTeamExists              = errors.New("team already exists")
+	ErrTeamNotFound            = errors.New("team not found")
+	ErrUserNotFound            = errors.New("user not found")
+	ErrUserExists              = errors.New("user already exists")
+	ErrPullRequestExists       = errors.New("pull request already exists")
+	ErrPullRequestNotFound     = errors.New("pull request not found")
+	ErrReviewerNotAssigned     = errors.New("reviewer not assigned to pull request")
+	ErrReviewerAlreadyAssigned = errors.New("reviewer already assigned to pull request")
+	ErrChecklistItemNotFound   = errors.New("checklist item not found")
+	ErrCalendarNotFound        = errors.New("team calendar not found")
+	ErrRotationNotFound        = errors.New("team rotation not found")
+	ErrExclusionNotFound       = errors.New("reviewer exclusion not found")
+	ErrDependencyCycle         = errors.New("pull request dependency cycle")
+	ErrDuplicatePullRequest    = errors.New("open pull request with the same name already exists")
+	ErrReviewSessionNotFound   = errors.New("review session not found")
+	ErrFreezeNotFound          = errors.New("assignment freeze not found")
+	ErrUserVersionConflict     = errors.New("user version does not match")
 
 	errTxRequired = errors.New("transaction is required")
 )
 
 type Repository struct {
-	pool *pgxpool.Pool
+	pool   *timedPool
+	compat Compat
+	fields *fieldcrypt.Keyring
 }
 
-func New(pool *pgxpool.Pool) *Repository {
-	return &Repository{pool: pool}
+func New(pool, readPool *pgxpool.Pool, acquireTimeout time.Duration, compat Compat, fields *fieldcrypt.Keyring) *Repository {
+	return &Repository{pool: &timedPool{Pool: pool, readPool: readPool, acquireTimeout: acquireTimeout}, compat: compat, fields: fields}
 }
 
 func (r *Repository) Pool() *pgxpool.Pool {
-	return r.pool
+	return r.pool.Pool
 }
 
 func (r *Repository) RunInTx(ctx context.Context, fn func(context.Context, pgx.Tx) error) error {
@@ -47,6 +64,7 @@ func (r *Repository) RunInTx(ctx context.Context, fn func(context.Context, pgx.T
 		return fmt.Errorf("begin tx: %w", err)
 	}
 
+	ctx = ctxutil.WithTx(ctx, tx)
 	if err := fn(ctx, tx); err != nil {
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
 			return fmt.Errorf("rollback tx: %v (original err: %w)", rbErr, err)
@@ -54,6 +72,13 @@ func (r *Repository) RunInTx(ctx context.Context, fn func(context.Context, pgx.T
 		return err
 	}
 
+	if ctxutil.DryRun(ctx) {
+		if err := tx.Rollback(ctx); err != nil {
+			return fmt.Errorf("rollback dry-run tx: %w", err)
+		}
+		return nil
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("commit tx: %w", err)
 	}
@@ -61,27 +86,45 @@ func (r *Repository) RunInTx(ctx context.Context, fn func(context.Context, pgx.T
 	return nil
 }
 
-func (r *Repository) InsertTeam(ctx context.Context, tx pgx.Tx, teamName string) (int64, error) {
+func (r *Repository) InsertTeam(ctx context.Context, tx pgx.Tx, teamName string) (domain.Team, error) {
 	if tx == nil {
-		return 0, errTxRequired
+		return domain.Team{}, errTxRequired
 	}
 
-	var id int64
-	err := tx.QueryRow(ctx, `INSERT INTO teams (team_name) VALUES ($1) RETURNING team_id`, teamName).Scan(&id)
+	var team domain.Team
+	err := tx.QueryRow(ctx, `
+		INSERT INTO teams (team_name) VALUES ($1)
+		RETURNING team_id, team_name, checklist_required, unique_open_pr_names, ramp_up_days, mentoring_shadows, COALESCE(assignment_strategy, '')
+	`, teamName).Scan(&team.ID, &team.Name, &team.ChecklistRequired, &team.UniqueOpenPRNames, &team.RampUpDays, &team.MentoringShadows, &team.AssignmentStrategy)
 	if err != nil {
 		if isUniqueViolation(err) {
-			return 0, ErrTeamExists
+			return domain.Team{}, ErrTeamExists
 		}
-		return 0, fmt.Errorf("insert team: %w", err)
+		return domain.Team{}, fmt.Errorf("insert team: %w", err)
 	}
 
-	return id, nil
+	return team, nil
 }
 
 func (r *Repository) GetTeamByName(ctx context.Context, teamName string) (domain.Team, error) {
+	team, err := r.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	members, err := r.listTeamMembersByTeamID(ctx, team.ID)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	team.Members = members
+
+	return team, nil
+}
+
+func (r *Repository) GetTeamSettings(ctx context.Context, teamName string) (domain.Team, error) {
 	var team domain.Team
-	err := r.pool.QueryRow(ctx, `SELECT team_id, team_name FROM teams WHERE team_name = $1`, teamName).
-		Scan(&team.ID, &team.Name)
+	err := r.pool.QueryRow(ctx, `SELECT team_id, team_name, checklist_required, unique_open_pr_names, ramp_up_days, mentoring_shadows, COALESCE(assignment_strategy, '') FROM teams WHERE lower(team_name) = lower($1)`, teamName).
+		Scan(&team.ID, &team.Name, &team.ChecklistRequired, &team.UniqueOpenPRNames, &team.RampUpDays, &team.MentoringShadows, &team.AssignmentStrategy)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return domain.Team{}, ErrTeamNotFound
 	}
@@ -89,23 +132,63 @@ func (r *Repository) GetTeamByName(ctx context.Context, teamName string) (domain
 		return domain.Team{}, fmt.Errorf("select team: %w", err)
 	}
 
-	members, err := r.listTeamMembersByTeamID(ctx, team.ID)
+	checklist, err := r.listChecklistItems(ctx, team.ID)
 	if err != nil {
 		return domain.Team{}, err
 	}
-	team.Members = members
+	team.Checklist = checklist
+
+	quorum, err := r.ListQuorumRules(ctx, team.ID)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	team.Quorum = quorum
 
 	return team, nil
 }
 
+func (r *Repository) ListActiveTeamMembers(ctx context.Context, teamID int64) ([]domain.TeamMember, error) {
+	return r.listTeamMembers(ctx, r.pool, teamID, true)
+}
+
 func (r *Repository) listTeamMembersByTeamID(ctx context.Context, teamID int64) ([]domain.TeamMember, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT u.user_id, u.username, u.is_active
-		FROM team_memberships tm
-		JOIN users u ON u.user_id = tm.user_id
-		WHERE tm.team_id = $1
+	return r.listTeamMembers(ctx, r.pool, teamID, false)
+}
+
+func (r *Repository) LockTeamByName(ctx context.Context, tx pgx.Tx, teamName string) (domain.Team, error) {
+	if tx == nil {
+		return domain.Team{}, errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('team:' || lower($1)))`, teamName); err != nil {
+		return domain.Team{}, fmt.Errorf("lock team name: %w", err)
+	}
+
+	var team domain.Team
+	err := tx.QueryRow(ctx, `
+		SELECT team_id, team_name FROM teams WHERE lower(team_name) = lower($1) FOR UPDATE
+	`, teamName).Scan(&team.ID, &team.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Team{}, ErrTeamNotFound
+	}
+	if err != nil {
+		return domain.Team{}, fmt.Errorf("select team for update: %w", err)
+	}
+
+	members, err := r.listTeamMembers(ctx, tx, team.ID, false)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	team.Members = members
+
+	return team, nil
+}
+
+func (r *Repository) listTeamMembers(ctx context.Context, q querier, teamID int64, activeOnly bool) ([]domain.TeamMember, error) {
+	rows, err := q.Query(ctx, teamMemberQuery+`
+		  AND (NOT $2 OR u.is_active)
 		ORDER BY u.username
-	`, teamID)
+	`, teamID, activeOnly)
 	if err != nil {
 		return nil, fmt.Errorf("select team members: %w", err)
 	}
@@ -113,15 +196,20 @@ func (r *Repository) listTeamMembersByTeamID(ctx context.Context, teamID int64)
 
 	var members []domain.TeamMember
 	for rows.Next() {
-		var m domain.TeamMember
-		if err := rows.Scan(&m.UserID, &m.Username, &m.IsActive); err != nil {
-			return nil, fmt.Errorf("scan team member: %w", err)
+		m, err := r.scanTeamMember(rows)
+		if err != nil {
+			return nil, err
 		}
 		members = append(members, m)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("iterate team members: %w", err)
 	}
+	if r.fields != nil {
+		slices.SortStableFunc(members, func(a, b domain.TeamMember) int {
+			return strings.Compare(a.Username, b.Username)
+		})
+	}
 
 	return members, nil
 }
@@ -131,16 +219,22 @@ func (r *Repository) UpsertUser(ctx context.Context, tx pgx.Tx, user domain.User
 		return domain.User{}, errTxRequired
 	}
 
+	username, err := r.sealText(user.Username)
+	if err != nil {
+		return domain.User{}, err
+	}
+
 	var stored domain.User
 	if err := tx.QueryRow(ctx, `
-		INSERT INTO users (user_id, username, is_active)
-		VALUES ($1, $2, $3)
+		INSERT INTO users (user_id, username, is_active, seniority)
+		VALUES ($1, $2, $3, COALESCE(NULLIF($4, ''), 'middle'))
 		ON CONFLICT (user_id)
 		DO UPDATE SET username = EXCLUDED.username,
 		              is_active = EXCLUDED.is_active,
+		              seniority = COALESCE(NULLIF($4, ''), users.seniority),
 		              updated_at = NOW()
-		RETURNING user_id, username, is_active
-	`, user.ID, user.Username, user.IsActive).Scan(&stored.ID, &stored.Username, &stored.IsActive); err != nil {
+		RETURNING user_id, username, is_active, seniority, version
+	`, user.ID, username, user.IsActive, string(user.Seniority)).Scan(&stored.ID, r.openText(&stored.Username), &stored.IsActive, &stored.Seniority, &stored.Version); err != nil {
 		return domain.User{}, fmt.Errorf("upsert user: %w", err)
 	}
 
@@ -152,13 +246,14 @@ func (r *Repository) UpsertMembership(ctx context.Context, tx pgx.Tx, teamID int
 		return errTxRequired
 	}
 
-	if _, err := tx.Exec(ctx, `DELETE FROM team_memberships WHERE user_id = $1`, userID); err != nil {
+	if _, err := tx.Exec(ctx, `DELETE FROM team_memberships WHERE user_id = $1 AND team_id <> $2`, userID, teamID); err != nil {
 		return fmt.Errorf("delete previous membership: %w", err)
 	}
 
 	if _, err := tx.Exec(ctx, `
 		INSERT INTO team_memberships (team_id, user_id)
 		VALUES ($1, $2)
+		ON CONFLICT (team_id, user_id) DO NOTHING
 	`, teamID, userID); err != nil {
 		return fmt.Errorf("upsert membership: %w", err)
 	}
@@ -166,18 +261,33 @@ func (r *Repository) UpsertMembership(ctx context.Context, tx pgx.Tx, teamID int
 	return nil
 }
 
+func (r *Repository) DeleteMembership(ctx context.Context, tx pgx.Tx, teamID int64, userID string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM team_memberships
+		WHERE team_id = $1 AND user_id = $2
+	`, teamID, userID); err != nil {
+		return fmt.Errorf("delete membership: %w", err)
+	}
+
+	return nil
+}
+
 func (r *Repository) GetUser(ctx context.Context, userID string) (domain.User, error) {
 	var user domain.User
 	var teamID sql.NullInt64
 	var teamName sql.NullString
 
 	err := r.pool.QueryRow(ctx, `
-		SELECT u.user_id, u.username, u.is_active, tm.team_id, t.team_name
+		SELECT u.user_id, u.username, u.is_active, u.seniority, u.version, tm.team_id, t.team_name
 		FROM users u
 		LEFT JOIN team_memberships tm ON tm.user_id = u.user_id
 		LEFT JOIN teams t ON t.team_id = tm.team_id
 		WHERE u.user_id = $1
-	`, userID).Scan(&user.ID, &user.Username, &user.IsActive, &teamID, &teamName)
+	`, userID).Scan(&user.ID, r.openText(&user.Username), &user.IsActive, &user.Seniority, &user.Version, &teamID, &teamName)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return domain.User{}, ErrUserNotFound
 	}
@@ -208,13 +318,13 @@ func (r *Repository) SetUserActive(ctx context.Context, userID string, isActive
 			SET is_active = $2,
 			    updated_at = NOW()
 			WHERE user_id = $1
-			RETURNING user_id, username, is_active
+			RETURNING user_id, username, is_active, seniority, version
 		)
-		SELECT u.user_id, u.username, u.is_active, tm.team_id, t.team_name
+		SELECT u.user_id, u.username, u.is_active, u.seniority, u.version, tm.team_id, t.team_name
 		FROM updated u
 		LEFT JOIN team_memberships tm ON tm.user_id = u.user_id
 		LEFT JOIN teams t ON t.team_id = tm.team_id
-	`, userID, isActive).Scan(&user.ID, &user.Username, &user.IsActive, &teamID, &teamName)
+	`, userID, isActive).Scan(&user.ID, r.openText(&user.Username), &user.IsActive, &user.Seniority, &user.Version, &teamID, &teamName)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return domain.User{}, ErrUserNotFound
 	}
@@ -241,10 +351,18 @@ func (r *Repository) CreatePullRequest(ctx context.Context, tx pgx.Tx, pr domain
 
 	var createdAt time.Time
 	if err := tx.QueryRow(ctx, `
-		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status_id)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO pull_requests (pull_request_id, pull_request_name, title, author_id, status_id, review_due_at, enforce_unique_name, lines_changed, assignment_deferred_at)
+		VALUES ($1, $2, $2, $3, $4, $5, (
+			SELECT COALESCE(bool_or(t.unique_open_pr_names), FALSE)
+			FROM team_memberships tm
+			JOIN teams t ON t.team_id = tm.team_id
+			WHERE tm.user_id = $3
+		), $6, $7)
 		RETURNING created_at
-	`, pr.ID, pr.Name, pr.AuthorID, prStatusOpenID).Scan(&createdAt); err != nil {
+	`, pr.ID, pr.Name, pr.AuthorID, prStatusOpenID, pr.ReviewDueAt, pr.LinesChanged, pr.AssignmentDeferredAt).Scan(&createdAt); err != nil {
+		if isConstraintViolation(err, "idx_pull_requests_open_name_unique") {
+			return domain.PullRequest{}, ErrDuplicatePullRequest
+		}
 		if isUniqueViolation(err) {
 			return domain.PullRequest{}, ErrPullRequestExists
 		}
@@ -256,69 +374,144 @@ func (r *Repository) CreatePullRequest(ctx context.Context, tx pgx.Tx, pr domain
 }
 
 func (r *Repository) GetPullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
-	row := r.pool.QueryRow(ctx, `
-		SELECT pr.pull_request_id,
-		       pr.pull_request_name,
+	return r.getPullRequest(ctx, r.pool, prID, false)
+}
+
+func (r *Repository) GetPullRequestIncludingDeleted(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return r.getPullRequest(ctx, r.pool, prID, true)
+}
+
+func (r *Repository) GetPullRequestTx(ctx context.Context, tx pgx.Tx, prID string, includeDeleted bool) (domain.PullRequest, error) {
+	if tx == nil {
+		return domain.PullRequest{}, errTxRequired
+	}
+	return r.getPullRequest(ctx, tx, prID, includeDeleted)
+}
+
+func (r *Repository) LockPullRequest(ctx context.Context, tx pgx.Tx, prID string) (domain.PullRequest, error) {
+	if tx == nil {
+		return domain.PullRequest{}, errTxRequired
+	}
+
+	pr, err := scanPullRequest(tx.QueryRow(ctx, `
+		SELECT `+r.pullRequestColumns()+`
+		FROM pull_requests pr
+		WHERE pr.pull_request_id = $1 AND pr.deleted_at IS NULL
+		FOR UPDATE
+	`, prID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, fmt.Errorf("lock pull request: %w", err)
+	}
+
+	if err := r.loadPullRequestDetails(ctx, tx, &pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+	return pr, nil
+}
+
+func (r *Repository) pullRequestColumns() string {
+	return `pr.pull_request_id,
+		       ` + r.prName() + `,
 		       pr.author_id,
-		       s.code,
+		       (SELECT s.code FROM pull_request_statuses s WHERE s.status_id = pr.status_id),
 		       pr.created_at,
-		       pr.merged_at
+		       pr.merged_at,
+		       pr.merged_by,
+		       pr.review_due_at,
+		       pr.deleted_at,
+		       pr.deleted_by,
+		       pr.approvals_reset_at,
+		       pr.lines_changed,
+		       pr.assignment_deferred_at`
+}
+
+func (r *Repository) getPullRequest(ctx context.Context, q querier, prID string, includeDeleted bool) (domain.PullRequest, error) {
+	row := q.QueryRow(ctx, `
+		SELECT `+r.pullRequestColumns()+`
 		FROM pull_requests pr
-		JOIN pull_request_statuses s ON s.status_id = pr.status_id
 		WHERE pr.pull_request_id = $1
-	`, prID)
+		  AND ($2 OR pr.deleted_at IS NULL)
+	`, prID, includeDeleted)
 
-	var pr domain.PullRequest
-	var status string
-	var mergedAt sql.NullTime
-	if err := row.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status, &pr.CreatedAt, &mergedAt); err != nil {
+	pr, err := scanPullRequest(row)
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.PullRequest{}, ErrPullRequestNotFound
 		}
 		return domain.PullRequest{}, fmt.Errorf("select pull request: %w", err)
 	}
+
+	if err := r.loadPullRequestDetails(ctx, q, &pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+	return pr, nil
+}
+
+func scanPullRequest(row pgx.Row) (domain.PullRequest, error) {
+	var pr domain.PullRequest
+	var status string
+	var mergedAt, reviewDueAt, deletedAt, approvalsResetAt, deferredAt sql.NullTime
+	if err := row.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status, &pr.CreatedAt, &mergedAt, &pr.MergedBy, &reviewDueAt, &deletedAt, &pr.DeletedBy, &approvalsResetAt, &pr.LinesChanged, &deferredAt); err != nil {
+		return domain.PullRequest{}, err
+	}
 	pr.Status = domain.PullRequestStatus(status)
 
 	if mergedAt.Valid {
 		t := mergedAt.Time
 		pr.MergedAt = &t
 	}
-
-	reviewers, err := r.ListReviewers(ctx, prID)
-	if err != nil {
-		return domain.PullRequest{}, err
+	if reviewDueAt.Valid {
+		t := reviewDueAt.Time
+		pr.ReviewDueAt = &t
+	}
+	if deletedAt.Valid {
+		t := deletedAt.Time
+		pr.DeletedAt = &t
+	}
+	if approvalsResetAt.Valid {
+		t := approvalsResetAt.Time
+		pr.ApprovalsResetAt = &t
+	}
+	if deferredAt.Valid {
+		t := deferredAt.Time
+		pr.AssignmentDeferredAt = &t
 	}
-	pr.Reviewers = reviewers
 
 	return pr, nil
 }
 
-func (r *Repository) ListReviewers(ctx context.Context, prID string) ([]string, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT reviewer_id
-		FROM pr_reviewers
-		WHERE pull_request_id = $1
-		ORDER BY assigned_at
-	`, prID)
+func (r *Repository) loadPullRequestDetails(ctx context.Context, q querier, pr *domain.PullRequest) error {
+	assignments, err := r.listReviewerAssignments(ctx, q, pr.ID)
 	if err != nil {
-		return nil, fmt.Errorf("select reviewers: %w", err)
+		return err
 	}
-	defer rows.Close()
-
-	var reviewers []string
-	for rows.Next() {
-		var reviewerID string
-		if err := rows.Scan(&reviewerID); err != nil {
-			return nil, fmt.Errorf("scan reviewer: %w", err)
+	pr.Assignments = assignments
+	pr.Reviewers = nil
+	pr.Shadows = make([]string, 0)
+	for _, a := range assignments {
+		if a.Kind == domain.AssignmentKindShadow {
+			pr.Shadows = append(pr.Shadows, a.ReviewerID)
+		} else {
+			pr.Reviewers = append(pr.Reviewers, a.ReviewerID)
 		}
-		reviewers = append(reviewers, reviewerID)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("iterate reviewers: %w", err)
+	checklist, err := r.listPullRequestChecklist(ctx, q, pr.ID)
+	if err != nil {
+		return err
+	}
+	pr.Checklist = checklist
+
+	blockers, err := r.listBlockers(ctx, q, pr.ID)
+	if err != nil {
+		return err
 	}
+	pr.BlockedBy = blockers
 
-	return reviewers, nil
+	return nil
 }
 
 func (r *Repository) AddReviewers(ctx context.Context, tx pgx.Tx, prID string, reviewerIDs []string) error {
@@ -330,28 +523,38 @@ func (r *Repository) AddReviewers(ctx context.Context, tx pgx.Tx, prID string, r
 	}
 
 	for _, reviewerID := range reviewerIDs {
-		if _, err := tx.Exec(ctx, `
-			INSERT INTO pr_reviewers (pull_request_id, reviewer_id)
-			VALUES ($1, $2)
-		`, prID, reviewerID); err != nil {
-			if isUniqueViolation(err) {
-				return fmt.Errorf("reviewer already assigned: %w", err)
-			}
+		var kind string
+		err := tx.QueryRow(ctx, `
+			WITH inserted AS (
+				INSERT INTO pr_reviewers (pull_request_id, reviewer_id)
+				VALUES ($1, $2)
+				ON CONFLICT (pull_request_id, reviewer_id) DO NOTHING
+				RETURNING kind
+			)
+			SELECT kind FROM inserted
+			UNION ALL
+			SELECT kind FROM pr_reviewers WHERE pull_request_id = $1 AND reviewer_id = $2
+			LIMIT 1
+		`, prID, reviewerID).Scan(&kind)
+		if err != nil {
 			return fmt.Errorf("insert reviewer: %w", err)
 		}
+		if kind != string(domain.AssignmentKindRegular) {
+			return ErrReviewerAlreadyAssigned
+		}
 	}
 
 	return nil
 }
 
-func (r *Repository) ReplaceReviewer(ctx context.Context, tx pgx.Tx, prID, oldReviewerID, newReviewerID string) error {
+func (r *Repository) ReplaceReviewer(ctx context.Context, tx pgx.Tx, prID, oldReviewerID, newReviewerID, note string) error {
 	if tx == nil {
 		return errTxRequired
 	}
 
 	tag, err := tx.Exec(ctx, `
 		DELETE FROM pr_reviewers
-		WHERE pull_request_id = $1 AND reviewer_id = $2
+		WHERE pull_request_id = $1 AND reviewer_id = $2 AND kind = 'regular'
 	`, prID, oldReviewerID)
 	if err != nil {
 		return fmt.Errorf("delete reviewer: %w", err)
@@ -361,11 +564,11 @@ func (r *Repository) ReplaceReviewer(ctx context.Context, tx pgx.Tx, prID, oldRe
 	}
 
 	if _, err := tx.Exec(ctx, `
-		INSERT INTO pr_reviewers (pull_request_id, reviewer_id)
-		VALUES ($1, $2)
-	`, prID, newReviewerID); err != nil {
+		INSERT INTO pr_reviewers (pull_request_id, reviewer_id, reassigned, handoff_from, handoff_note)
+		VALUES ($1, $2, TRUE, $3, NULLIF($4, ''))
+	`, prID, newReviewerID, oldReviewerID, note); err != nil {
 		if isUniqueViolation(err) {
-			return fmt.Errorf("reviewer already assigned: %w", err)
+			return ErrReviewerAlreadyAssigned
 		}
 		return fmt.Errorf("insert reviewer: %w", err)
 	}
@@ -373,39 +576,51 @@ func (r *Repository) ReplaceReviewer(ctx context.Context, tx pgx.Tx, prID, oldRe
 	return nil
 }
 
-func (r *Repository) MarkPullRequestMerged(ctx context.Context, tx pgx.Tx, prID string, mergedAt time.Time) error {
+func (r *Repository) MarkPullRequestMerged(ctx context.Context, tx pgx.Tx, prID string, mergedAt time.Time, mergedBy *string) (domain.PullRequest, error) {
 	if tx == nil {
-		return errTxRequired
+		return domain.PullRequest{}, errTxRequired
 	}
 
-	tag, err := tx.Exec(ctx, `
-		UPDATE pull_requests
+	pr, err := scanPullRequest(tx.QueryRow(ctx, `
+		UPDATE pull_requests pr
 		SET status_id = $2,
-		    merged_at = COALESCE(merged_at, $3)
-		WHERE pull_request_id = $1
-	`, prID, prStatusMergedID, mergedAt)
+		    merged_at = COALESCE(merged_at, $3),
+		    merged_by = COALESCE(merged_by, $4),
+		    assignment_deferred_at = NULL
+		WHERE pr.pull_request_id = $1
+		RETURNING `+r.pullRequestColumns(), prID, prStatusMergedID, mergedAt, mergedBy))
 	if err != nil {
-		return fmt.Errorf("update pull request status: %w", err)
-	}
-	if tag.RowsAffected() == 0 {
-		return ErrPullRequestNotFound
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, fmt.Errorf("update pull request status: %w", err)
 	}
 
-	return nil
+	if err := r.loadPullRequestDetails(ctx, tx, &pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+	return pr, nil
 }
 
-func (r *Repository) ListPullRequestsForReviewer(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+func (r *Repository) ListPullRequestsForReviewer(ctx context.Context, userID string, page domain.Page, includeDeleted bool) ([]domain.PullRequestShort, error) {
+	order, err := pageClause(page, r.pullRequestSortColumns(), "pr.pull_request_id")
+	if err != nil {
+		return nil, err
+	}
+
 	rows, err := r.pool.Query(ctx, `
 		SELECT pr.pull_request_id,
-		       pr.pull_request_name,
+		       `+r.prName()+`,
 		       pr.author_id,
-		       s.code
+		       s.code,
+		       pr.deleted_at,
+		       pr.approvals_reset_at
 		FROM pr_reviewers rr
 		JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
 		JOIN pull_request_statuses s ON s.status_id = pr.status_id
 		WHERE rr.reviewer_id = $1
-		ORDER BY pr.created_at DESC
-	`, userID)
+		  AND ($2 OR pr.deleted_at IS NULL)
+		`+order, userID, includeDeleted)
 	if err != nil {
 		return nil, fmt.Errorf("select reviewer pull requests: %w", err)
 	}
@@ -415,10 +630,19 @@ func (r *Repository) ListPullRequestsForReviewer(ctx context.Context, userID str
 	for rows.Next() {
 		var pr domain.PullRequestShort
 		var status string
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status); err != nil {
+		var deletedAt, approvalsResetAt sql.NullTime
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status, &deletedAt, &approvalsResetAt); err != nil {
 			return nil, fmt.Errorf("scan pull request short: %w", err)
 		}
 		pr.Status = domain.PullRequestStatus(status)
+		if deletedAt.Valid {
+			t := deletedAt.Time
+			pr.DeletedAt = &t
+		}
+		if approvalsResetAt.Valid {
+			t := approvalsResetAt.Time
+			pr.ApprovalsResetAt = &t
+		}
 		result = append(result, pr)
 	}
 	if err := rows.Err(); err != nil {
@@ -428,36 +652,44 @@ func (r *Repository) ListPullRequestsForReviewer(ctx context.Context, userID str
 	return result, nil
 }
 
-func (r *Repository) ListRandomActiveTeamMembers(ctx context.Context, teamID int64, exclude []string, limit int) ([]domain.TeamMember, error) {
+func (r *Repository) ListLeastLoadedActiveTeamMembers(ctx context.Context, teamID int64, exclude []string, limit int) ([]domain.TeamMember, error) {
 	if exclude == nil {
 		exclude = []string{}
 	}
 
 	rows, err := r.pool.Query(ctx, `
-		SELECT u.user_id, u.username, u.is_active
+		SELECT u.user_id, u.username, u.is_active, u.seniority
 		FROM team_memberships tm
+		JOIN teams t ON t.team_id = tm.team_id
 		JOIN users u ON u.user_id = tm.user_id
+		LEFT JOIN (
+			SELECT rr.reviewer_id, COUNT(*) AS open_reviews
+			FROM pr_reviewers rr
+			JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+			WHERE pr.status_id = $4 AND rr.kind = 'regular' AND pr.deleted_at IS NULL
+			GROUP BY rr.reviewer_id
+		) load ON load.reviewer_id = u.user_id
 		WHERE tm.team_id = $1
 		  AND u.is_active = TRUE
 		  AND u.user_id <> ALL($2::text[])
-		ORDER BY random()
+		ORDER BY tm.joined_at + make_interval(days => t.ramp_up_days) > NOW(), COALESCE(load.open_reviews, 0), random()
 		LIMIT $3
-	`, teamID, exclude, limit)
+	`, teamID, exclude, limit, prStatusOpenID)
 	if err != nil {
-		return nil, fmt.Errorf("select random team members: %w", err)
+		return nil, fmt.Errorf("select least loaded team members: %w", err)
 	}
 	defer rows.Close()
 
 	var members []domain.TeamMember
 	for rows.Next() {
 		var m domain.TeamMember
-		if err := rows.Scan(&m.UserID, &m.Username, &m.IsActive); err != nil {
-			return nil, fmt.Errorf("scan random member: %w", err)
+		if err := rows.Scan(&m.UserID, r.openText(&m.Username), &m.IsActive, &m.Seniority); err != nil {
+			return nil, fmt.Errorf("scan least loaded member: %w", err)
 		}
 		members = append(members, m)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("iterate random members: %w", err)
+		return nil, fmt.Errorf("iterate least loaded members: %w", err)
 	}
 
 	return members, nil
@@ -470,3 +702,11 @@ func isUniqueViolation(err error) bool {
 	}
 	return false
 }
+
+func isConstraintViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName == constraint
+	}
+	return false
+}
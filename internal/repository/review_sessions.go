@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) ListReviewSessionCandidates(ctx context.Context, reviewerID string, now time.Time) ([]domain.ReviewSessionItem, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT pr.pull_request_id, `+r.prName()+`, pr.author_id, pr.review_due_at, pr.lines_changed
+		FROM pr_reviewers rr
+		JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+		WHERE rr.reviewer_id = $1
+		  AND rr.kind = 'regular'
+		  AND rr.completed_at IS NULL
+		  AND pr.status_id = $2
+		  AND pr.deleted_at IS NULL
+		  AND (rr.snoozed_until IS NULL OR rr.snoozed_until <= $3)
+		  AND NOT EXISTS (
+		      SELECT 1
+		      FROM pr_links l
+		      JOIN pull_requests b ON b.pull_request_id = l.blocked_by_id
+		      WHERE l.pull_request_id = pr.pull_request_id
+		        AND b.status_id = $2
+		        AND b.deleted_at IS NULL
+		  )
+		  AND NOT EXISTS (
+		      SELECT 1
+		      FROM review_session_items i
+		      JOIN review_sessions s ON s.session_id = i.session_id
+		      WHERE i.pull_request_id = pr.pull_request_id
+		        AND s.reviewer_id = $1
+		        AND s.status = 'accepted'
+		  )
+		ORDER BY pr.review_due_at NULLS LAST, pr.created_at, pr.pull_request_id
+	`, reviewerID, prStatusOpenID, now)
+	if err != nil {
+		return nil, fmt.Errorf("select review session candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domain.ReviewSessionItem
+	for rows.Next() {
+		var item domain.ReviewSessionItem
+		var reviewDueAt sql.NullTime
+		if err := rows.Scan(&item.PullRequestID, &item.Name, &item.AuthorID, &reviewDueAt, &item.LinesChanged); err != nil {
+			return nil, fmt.Errorf("scan review session candidate: %w", err)
+		}
+		if reviewDueAt.Valid {
+			t := reviewDueAt.Time
+			item.ReviewDueAt = &t
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate review session candidates: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *Repository) CreateReviewSession(ctx context.Context, tx pgx.Tx, session domain.ReviewSession) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM review_sessions WHERE reviewer_id = $1 AND status = 'suggested'
+	`, session.ReviewerID); err != nil {
+		return fmt.Errorf("discard suggested review sessions: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO review_sessions (session_id, reviewer_id, status, line_budget, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, session.ID, session.ReviewerID, string(session.Status), session.LineBudget, session.CreatedAt); err != nil {
+		if isConstraintViolation(err, "review_sessions_reviewer_id_fkey") {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("insert review session: %w", err)
+	}
+
+	for i, item := range session.Items {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO review_session_items (session_id, pull_request_id, position, estimated_lines)
+			VALUES ($1, $2, $3, $4)
+		`, session.ID, item.PullRequestID, i, item.EstimatedLines); err != nil {
+			return fmt.Errorf("insert review session item: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) GetReviewSession(ctx context.Context, sessionID string) (domain.ReviewSession, error) {
+	return r.getReviewSession(ctx, r.pool, sessionID, "")
+}
+
+func (r *Repository) GetReviewSessionForUpdate(ctx context.Context, tx pgx.Tx, sessionID string) (domain.ReviewSession, error) {
+	if tx == nil {
+		return domain.ReviewSession{}, errTxRequired
+	}
+	return r.getReviewSession(ctx, tx, sessionID, "FOR UPDATE")
+}
+
+func (r *Repository) getReviewSession(ctx context.Context, q querier, sessionID, lock string) (domain.ReviewSession, error) {
+	var session domain.ReviewSession
+	var acceptedAt, completedAt sql.NullTime
+	err := q.QueryRow(ctx, `
+		SELECT session_id, reviewer_id, status, line_budget, created_at, accepted_at, completed_at
+		FROM review_sessions
+		WHERE session_id = $1
+	`+lock, sessionID).Scan(&session.ID, &session.ReviewerID, &session.Status, &session.LineBudget, &session.CreatedAt, &acceptedAt, &completedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ReviewSession{}, ErrReviewSessionNotFound
+	}
+	if err != nil {
+		return domain.ReviewSession{}, fmt.Errorf("select review session: %w", err)
+	}
+	if acceptedAt.Valid {
+		t := acceptedAt.Time
+		session.AcceptedAt = &t
+	}
+	if completedAt.Valid {
+		t := completedAt.Time
+		session.CompletedAt = &t
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT pr.pull_request_id, `+r.prName()+`, pr.author_id, pr.review_due_at, pr.lines_changed, i.estimated_lines
+		FROM review_session_items i
+		JOIN pull_requests pr ON pr.pull_request_id = i.pull_request_id
+		WHERE i.session_id = $1
+		ORDER BY i.position
+	`, sessionID)
+	if err != nil {
+		return domain.ReviewSession{}, fmt.Errorf("select review session items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item domain.ReviewSessionItem
+		var reviewDueAt sql.NullTime
+		if err := rows.Scan(&item.PullRequestID, &item.Name, &item.AuthorID, &reviewDueAt, &item.LinesChanged, &item.EstimatedLines); err != nil {
+			return domain.ReviewSession{}, fmt.Errorf("scan review session item: %w", err)
+		}
+		if reviewDueAt.Valid {
+			t := reviewDueAt.Time
+			item.ReviewDueAt = &t
+		}
+		session.Items = append(session.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ReviewSession{}, fmt.Errorf("iterate review session items: %w", err)
+	}
+
+	return session, nil
+}
+
+func (r *Repository) UpdateReviewSessionStatus(ctx context.Context, tx pgx.Tx, sessionID string, status domain.ReviewSessionStatus, at time.Time) ([]string, error) {
+	if tx == nil {
+		return nil, errTxRequired
+	}
+
+	completed := status == domain.ReviewSessionCompleted
+	if _, err := tx.Exec(ctx, `
+		UPDATE review_sessions
+		SET status = $2,
+		    accepted_at = COALESCE(accepted_at, $3),
+		    completed_at = CASE WHEN $4 THEN $3 ELSE completed_at END
+		WHERE session_id = $1
+	`, sessionID, string(status), at, completed); err != nil {
+		return nil, fmt.Errorf("update review session: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `
+		UPDATE pr_reviewers rr
+		SET first_response_at = COALESCE(rr.first_response_at, $3),
+		    approval_reset_at = NULL,
+		    completed_at = CASE WHEN $4 THEN COALESCE(rr.completed_at, $3) ELSE rr.completed_at END
+		FROM review_session_items i, review_sessions s, pull_requests pr
+		WHERE i.session_id = $1
+		  AND s.session_id = i.session_id
+		  AND rr.pull_request_id = i.pull_request_id
+		  AND rr.reviewer_id = s.reviewer_id
+		  AND rr.kind = 'regular'
+		  AND pr.pull_request_id = i.pull_request_id
+		  AND pr.status_id = $2
+		  AND pr.deleted_at IS NULL
+		RETURNING rr.pull_request_id
+	`, sessionID, prStatusOpenID, at, completed)
+	if err != nil {
+		return nil, fmt.Errorf("update review session assignments: %w", err)
+	}
+
+	return collectUserIDs(rows, "review session pull request")
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) ApproveReview(ctx context.Context, tx pgx.Tx, prID, reviewerID string, at time.Time) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	var assigned bool
+	if err := tx.QueryRow(ctx, `
+		WITH assigned AS (
+			SELECT pull_request_id, reviewer_id
+			FROM pr_reviewers
+			WHERE pull_request_id = $1 AND reviewer_id = $2 AND kind = 'regular'
+		),
+		approved AS (
+			INSERT INTO pr_reviews (pull_request_id, reviewer_id, approved_at)
+			SELECT pull_request_id, reviewer_id, $3 FROM assigned
+			ON CONFLICT (pull_request_id, reviewer_id) DO NOTHING
+		)
+		SELECT EXISTS (SELECT 1 FROM assigned)
+	`, prID, reviewerID, at).Scan(&assigned); err != nil {
+		return fmt.Errorf("insert review approval: %w", err)
+	}
+	if !assigned {
+		return ErrReviewerNotAssigned
+	}
+	return nil
+}
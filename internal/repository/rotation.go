@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) GetTeamRotation(ctx context.Context, teamID int64) (domain.Rotation, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT r.starts_on,
+		       m.user_id,
+		       COALESCE(u.seniority, ''),
+		       COALESCE(u.is_active, FALSE) AND tm.user_id IS NOT NULL
+		FROM team_rotations r
+		CROSS JOIN LATERAL unnest(r.members) WITH ORDINALITY AS m(user_id, pos)
+		LEFT JOIN users u ON u.user_id = m.user_id
+		LEFT JOIN team_memberships tm ON tm.team_id = r.team_id AND tm.user_id = m.user_id
+		WHERE r.team_id = $1
+		ORDER BY m.pos
+	`, teamID)
+	if err != nil {
+		return domain.Rotation{}, fmt.Errorf("select team rotation: %w", err)
+	}
+	defer rows.Close()
+
+	var rotation domain.Rotation
+	found := false
+	for rows.Next() {
+		var m domain.RotationMember
+		if err := rows.Scan(&rotation.StartsOn, &m.UserID, &m.Seniority, &m.Eligible); err != nil {
+			return domain.Rotation{}, fmt.Errorf("scan team rotation: %w", err)
+		}
+		found = true
+		rotation.Members = append(rotation.Members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.Rotation{}, fmt.Errorf("iterate team rotation: %w", err)
+	}
+	if !found {
+		return domain.Rotation{}, ErrRotationNotFound
+	}
+
+	rotation.StartsOn = rotation.StartsOn.UTC()
+	return rotation, nil
+}
+
+func (r *Repository) SetTeamRotation(ctx context.Context, tx pgx.Tx, teamID int64, members []string, startsOn time.Time) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO team_rotations (team_id, members, starts_on)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_id)
+		DO UPDATE SET members = EXCLUDED.members, starts_on = EXCLUDED.starts_on, updated_at = NOW()
+	`, teamID, members, startsOn); err != nil {
+		return fmt.Errorf("upsert team rotation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteTeamRotation(ctx context.Context, tx pgx.Tx, teamID int64) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM team_rotations WHERE team_id = $1`, teamID); err != nil {
+		return fmt.Errorf("delete team rotation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) SnoozeReviewer(ctx context.Context, tx pgx.Tx, prID, reviewerID string, until time.Time, spent time.Duration) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE pr_reviewers
+		SET snoozed_until = $3,
+		    snooze_used_seconds = snooze_used_seconds + $4
+		WHERE pull_request_id = $1 AND reviewer_id = $2
+	`, prID, reviewerID, until, int64(spent/time.Second))
+	if err != nil {
+		return fmt.Errorf("snooze reviewer: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrReviewerNotAssigned
+	}
+
+	return nil
+}
+
+func (r *Repository) WakeSnoozedReviewers(ctx context.Context, now time.Time) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE pr_reviewers
+		SET snoozed_until = NULL
+		WHERE snoozed_until IS NOT NULL AND snoozed_until <= $1
+	`, now)
+	if err != nil {
+		return 0, fmt.Errorf("wake snoozed reviewers: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *Repository) ListReviewQueue(ctx context.Context, userID string, now time.Time, hideBlocked bool, page domain.Page) ([]domain.PullRequestShort, error) {
+	order, err := pageClause(page, r.pullRequestSortColumns(), "pr.pull_request_id")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT pr.pull_request_id,
+		       `+r.prName()+`,
+		       pr.author_id,
+		       s.code
+		FROM pr_reviewers rr
+		JOIN pull_requests pr ON pr.pull_request_id = rr.pull_request_id
+		JOIN pull_request_statuses s ON s.status_id = pr.status_id
+		WHERE rr.reviewer_id = $1
+		  AND pr.status_id = $2
+		  AND pr.deleted_at IS NULL
+		  AND (rr.snoozed_until IS NULL OR rr.snoozed_until <= $3)
+		  AND NOT ($4 AND EXISTS (
+		      SELECT 1
+		      FROM pr_links l
+		      JOIN pull_requests b ON b.pull_request_id = l.blocked_by_id
+		      WHERE l.pull_request_id = pr.pull_request_id
+		        AND b.status_id = $2
+		        AND b.deleted_at IS NULL
+		  ))
+		`+order, userID, prStatusOpenID, now, hideBlocked)
+	if err != nil {
+		return nil, fmt.Errorf("select review queue: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.PullRequestShort
+	for rows.Next() {
+		var pr domain.PullRequestShort
+		var status string
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status); err != nil {
+			return nil, fmt.Errorf("scan review queue: %w", err)
+		}
+		pr.Status = domain.PullRequestStatus(status)
+		result = append(result, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate review queue: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) SoftDeletePullRequest(ctx context.Context, tx pgx.Tx, prID, deletedBy string, at time.Time) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE pull_requests
+		SET deleted_at = $2, deleted_by = NULLIF($3, '')
+		WHERE pull_request_id = $1 AND deleted_at IS NULL
+	`, prID, at, deletedBy)
+	if err != nil {
+		return fmt.Errorf("soft delete pull request: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrPullRequestNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) RestorePullRequest(ctx context.Context, tx pgx.Tx, prID string) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE pull_requests
+		SET deleted_at = NULL, deleted_by = NULL
+		WHERE pull_request_id = $1 AND deleted_at IS NOT NULL
+	`, prID)
+	if err != nil {
+		if isConstraintViolation(err, "idx_pull_requests_open_name_unique") {
+			return ErrDuplicatePullRequest
+		}
+		return fmt.Errorf("restore pull request: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrPullRequestNotFound
+	}
+
+	return nil
+}
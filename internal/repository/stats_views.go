@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var statsViews = []string{
+	"mv_user_open_reviews",
+	"mv_team_weekly_throughput",
+}
+
+func (r *Repository) RefreshStatsViews(ctx context.Context) error {
+	for _, view := range statsViews {
+		if _, err := r.pool.Exec(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY "+pgx.Identifier{view}.Sanitize()); err != nil {
+			return fmt.Errorf("refresh %s: %w", view, err)
+		}
+		if _, err := r.pool.Exec(ctx, `
+			INSERT INTO stats_view_refreshes (view_name, refreshed_at)
+			VALUES ($1, NOW())
+			ON CONFLICT (view_name) DO UPDATE SET refreshed_at = EXCLUDED.refreshed_at
+		`, view); err != nil {
+			return fmt.Errorf("record %s refresh: %w", view, err)
+		}
+	}
+	return nil
+}
+
+func (r *Repository) getStatsViewRefreshedAt(ctx context.Context, view string) (*time.Time, error) {
+	var refreshedAt time.Time
+	err := r.pool.QueryRow(ctx, `
+		SELECT refreshed_at FROM stats_view_refreshes WHERE view_name = $1
+	`, view).Scan(&refreshedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select %s refresh time: %w", view, err)
+	}
+	return &refreshedAt, nil
+}
+
+func (r *Repository) CountTeamPullRequestsInLastWeeks(ctx context.Context, teamID int64, now time.Time, weeks int) (int, error) {
+	var count int
+	if err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(SUM(created), 0)
+		FROM mv_team_weekly_throughput
+		WHERE team_id = $1
+		  AND week_start >= date_trunc('week', $2::timestamptz AT TIME ZONE 'UTC') - make_interval(weeks => $3)
+		  AND week_start < date_trunc('week', $2::timestamptz AT TIME ZONE 'UTC')
+	`, teamID, now, weeks).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count team pull requests: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (r *Repository) ListPullRequestStatuses(ctx context.Context, prIDs []string, defaultMinApprovals int) ([]domain.PullRequestStatusSummary, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT pr.pull_request_id,
+		       s.code,
+		       COALESCE(array_agg(rr.reviewer_id ORDER BY rr.reviewer_id)
+		                FILTER (WHERE rr.reviewer_id IS NOT NULL), '{}'),
+		       COALESCE(array_agg(rr.reviewer_id ORDER BY rr.reviewer_id)
+		                FILTER (WHERE rr.first_response_at IS NOT NULL AND rr.approval_reset_at IS NULL), '{}'),
+		       COUNT(rv.reviewer_id),
+		       COALESCE((
+		           SELECT o.value::int
+		           FROM policy_overrides o
+		           WHERE o.key = $2
+		             AND (o.scope = 'org'
+		                  OR (o.scope = 'team' AND o.team_id = tm.team_id)
+		                  OR (o.scope = 'pull_request' AND o.pull_request_id = pr.pull_request_id))
+		           ORDER BY CASE o.scope WHEN 'pull_request' THEN 0 WHEN 'team' THEN 1 ELSE 2 END
+		           LIMIT 1
+		       ), $3),
+		       NOT EXISTS (
+		           SELECT 1
+		           FROM team_quorum_rules q
+		           WHERE q.team_id = tm.team_id
+		             AND q.min_reviewers > (
+		                 SELECT COUNT(*)
+		                 FROM pr_reviewers qr
+		                 JOIN users u ON u.user_id = qr.reviewer_id
+		                 WHERE qr.pull_request_id = pr.pull_request_id AND qr.kind = 'regular' AND u.seniority = q.seniority
+		             )
+		       )
+		FROM pull_requests pr
+		JOIN pull_request_statuses s ON s.status_id = pr.status_id
+		LEFT JOIN LATERAL (
+		    SELECT m.team_id FROM team_memberships m WHERE m.user_id = pr.author_id LIMIT 1
+		) tm ON TRUE
+		LEFT JOIN pr_reviewers rr ON rr.pull_request_id = pr.pull_request_id AND rr.kind = 'regular'
+		LEFT JOIN pr_reviews rv ON rv.pull_request_id = rr.pull_request_id AND rv.reviewer_id = rr.reviewer_id
+		WHERE pr.pull_request_id = ANY($1) AND pr.deleted_at IS NULL
+		GROUP BY pr.pull_request_id, s.code, tm.team_id
+	`, prIDs, string(domain.PolicyMinApprovals), defaultMinApprovals)
+	if err != nil {
+		return nil, fmt.Errorf("select pull request statuses: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []domain.PullRequestStatusSummary
+	for rows.Next() {
+		var summary domain.PullRequestStatusSummary
+		var status string
+		if err := rows.Scan(&summary.ID, &status, &summary.Reviewers, &summary.Responded,
+			&summary.Approvals, &summary.RequiredApprovals, &summary.QuorumMet); err != nil {
+			return nil, fmt.Errorf("scan pull request status: %w", err)
+		}
+		summary.Status = domain.PullRequestStatus(status)
+		summaries = append(summaries, summary)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate pull request statuses: %w", err)
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (r *Repository) ListSyncChanges(ctx context.Context, after domain.SyncCursor, limit int) (domain.SyncPage, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT txid::text::bigint, seq, entity, entity_id
+		FROM sync_changes
+		WHERE (txid, seq) > ($1::text::xid8, $2)
+		  AND txid < pg_snapshot_xmin(pg_current_snapshot())
+		ORDER BY txid, seq
+		LIMIT $3
+	`, strconv.FormatInt(after.TxID, 10), after.Seq, limit+1)
+	if err != nil {
+		return domain.SyncPage{}, fmt.Errorf("select sync changes: %w", err)
+	}
+	defer rows.Close()
+
+	page := domain.SyncPage{Cursor: after}
+	type entityKey struct {
+		entity domain.SyncEntity
+		id     string
+	}
+	latest := make(map[entityKey]domain.SyncCursor)
+	for read := 0; rows.Next(); read++ {
+		if read == limit {
+			page.HasMore = true
+			break
+		}
+		var cursor domain.SyncCursor
+		var entity, id string
+		if err := rows.Scan(&cursor.TxID, &cursor.Seq, &entity, &id); err != nil {
+			return domain.SyncPage{}, fmt.Errorf("scan sync change: %w", err)
+		}
+		latest[entityKey{domain.SyncEntity(entity), id}] = cursor
+		page.Cursor = cursor
+	}
+	if err := rows.Err(); err != nil {
+		return domain.SyncPage{}, fmt.Errorf("iterate sync changes: %w", err)
+	}
+	rows.Close()
+
+	ids := make(map[domain.SyncEntity][]string, 3)
+	for key, cursor := range latest {
+		ids[key.entity] = append(ids[key.entity], key.id)
+		page.Changes = append(page.Changes, domain.SyncChange{Cursor: cursor, Entity: key.entity, ID: key.id, Deleted: true})
+	}
+	slices.SortFunc(page.Changes, func(a, b domain.SyncChange) int { return a.Cursor.Compare(b.Cursor) })
+
+	teams, err := r.syncTeams(ctx, ids[domain.SyncEntityTeam])
+	if err != nil {
+		return domain.SyncPage{}, err
+	}
+	users, err := r.syncUsers(ctx, ids[domain.SyncEntityUser])
+	if err != nil {
+		return domain.SyncPage{}, err
+	}
+	prs, err := r.syncPullRequests(ctx, ids[domain.SyncEntityPullRequest])
+	if err != nil {
+		return domain.SyncPage{}, err
+	}
+
+	for i := range page.Changes {
+		c := &page.Changes[i]
+		switch c.Entity {
+		case domain.SyncEntityTeam:
+			if t, ok := teams[domain.TeamNameKey(c.ID)]; ok {
+				c.Team, c.Deleted = &t, false
+			}
+		case domain.SyncEntityUser:
+			if u, ok := users[c.ID]; ok {
+				c.User, c.Deleted = &u, false
+			}
+		case domain.SyncEntityPullRequest:
+			if pr, ok := prs[c.ID]; ok {
+				c.PullRequest, c.Deleted = &pr, false
+			}
+		}
+	}
+
+	return page, nil
+}
+
+func (r *Repository) syncTeams(ctx context.Context, names []string) (map[string]domain.SyncTeam, error) {
+	result := make(map[string]domain.SyncTeam, len(names))
+	if len(names) == 0 {
+		return result, nil
+	}
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = domain.TeamNameKey(name)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT t.team_name,
+		       COALESCE(array_agg(tm.user_id ORDER BY tm.user_id) FILTER (WHERE tm.user_id IS NOT NULL), '{}'),
+		       GREATEST(t.updated_at, MAX(tm.updated_at))
+		FROM teams t
+		LEFT JOIN team_memberships tm ON tm.team_id = t.team_id
+		WHERE lower(t.team_name) = ANY($1)
+		GROUP BY t.team_id
+	`, keys)
+	if err != nil {
+		return nil, fmt.Errorf("select sync teams: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t domain.SyncTeam
+		if err := rows.Scan(&t.Name, &t.MemberIDs, &t.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan sync team: %w", err)
+		}
+		result[domain.TeamNameKey(t.Name)] = t
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate sync teams: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Repository) syncUsers(ctx context.Context, ids []string) (map[string]domain.SyncUser, error) {
+	result := make(map[string]domain.SyncUser, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.user_id, u.username, u.is_active, COALESCE(t.team_name, ''), GREATEST(u.updated_at, tm.updated_at)
+		FROM users u
+		LEFT JOIN team_memberships tm ON tm.user_id = u.user_id
+		LEFT JOIN teams t ON t.team_id = tm.team_id
+		WHERE u.user_id = ANY($1)
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("select sync users: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var u domain.SyncUser
+		if err := rows.Scan(&u.ID, r.openText(&u.Username), &u.IsActive, &u.TeamName, &u.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan sync user: %w", err)
+		}
+		result[u.ID] = u
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate sync users: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Repository) syncPullRequests(ctx context.Context, ids []string) (map[string]domain.SyncPullRequest, error) {
+	result := make(map[string]domain.SyncPullRequest, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT pr.pull_request_id,
+		       `+r.prName()+`,
+		       pr.author_id,
+		       s.code,
+		       COALESCE(array_agg(rr.reviewer_id ORDER BY rr.reviewer_id) FILTER (WHERE rr.reviewer_id IS NOT NULL), '{}'),
+		       pr.created_at,
+		       pr.merged_at,
+		       GREATEST(pr.updated_at, MAX(rr.updated_at))
+		FROM pull_requests pr
+		JOIN pull_request_statuses s ON s.status_id = pr.status_id
+		LEFT JOIN pr_reviewers rr ON rr.pull_request_id = pr.pull_request_id AND rr.kind = 'regular'
+		WHERE pr.pull_request_id = ANY($1) AND pr.deleted_at IS NULL
+		GROUP BY pr.pull_request_id, s.code
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("select sync pull requests: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pr domain.SyncPullRequest
+		var status string
+		var mergedAt sql.NullTime
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &status, &pr.Reviewers, &pr.CreatedAt, &mergedAt, &pr.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan sync pull request: %w", err)
+		}
+		pr.Status = domain.PullRequestStatus(status)
+		if mergedAt.Valid {
+			t := mergedAt.Time
+			pr.MergedAt = &t
+		}
+		result[pr.ID] = pr
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate sync pull requests: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Repository) SyncPurgedThrough(ctx context.Context) (domain.SyncCursor, error) {
+	var cursor domain.SyncCursor
+	if err := r.pool.QueryRow(ctx, `
+		SELECT purged_through_txid::text::bigint, purged_through FROM sync_watermark
+	`).Scan(&cursor.TxID, &cursor.Seq); err != nil {
+		return domain.SyncCursor{}, fmt.Errorf("select sync watermark: %w", err)
+	}
+	return cursor, nil
+}
+
+func (r *Repository) CompactSyncChanges(ctx context.Context, before time.Time) (int64, error) {
+	var removed int64
+	err := r.pool.QueryRow(ctx, `
+		WITH superseded AS (
+			DELETE FROM sync_changes c
+			WHERE c.changed_at < $1
+			  AND EXISTS (
+			      SELECT 1 FROM sync_changes n
+			      WHERE n.entity = c.entity AND n.entity_id = c.entity_id AND (n.txid, n.seq) > (c.txid, c.seq)
+			  )
+			RETURNING txid, seq
+		),
+		tombstones AS (
+			DELETE FROM sync_changes c
+			WHERE c.changed_at < $1
+			  AND NOT EXISTS (
+			      SELECT 1 FROM sync_changes n
+			      WHERE n.entity = c.entity AND n.entity_id = c.entity_id AND (n.txid, n.seq) > (c.txid, c.seq)
+			  )
+			  AND CASE c.entity
+			      WHEN 'team' THEN NOT EXISTS (SELECT 1 FROM teams t WHERE lower(t.team_name) = lower(c.entity_id))
+			      WHEN 'user' THEN NOT EXISTS (SELECT 1 FROM users u WHERE u.user_id = c.entity_id)
+			      ELSE NOT EXISTS (
+			          SELECT 1 FROM pull_requests pr
+			          WHERE pr.pull_request_id = c.entity_id AND pr.deleted_at IS NULL
+			      )
+			  END
+			RETURNING txid, seq
+		),
+		watermark AS (
+			UPDATE sync_watermark w
+			SET purged_through_txid = last.txid,
+			    purged_through = last.seq
+			FROM (SELECT txid, seq FROM tombstones ORDER BY txid DESC, seq DESC LIMIT 1) last
+			WHERE (last.txid, last.seq) > (w.purged_through_txid, w.purged_through)
+		)
+		SELECT (SELECT COUNT(*) FROM superseded) + (SELECT COUNT(*) FROM tombstones)
+	`, before).Scan(&removed)
+	if err != nil {
+		return 0, fmt.Errorf("compact sync changes: %w", err)
+	}
+	return removed, nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const teamMemberQuery = `
+		SELECT u.user_id, u.username, u.is_active, u.seniority,
+		       CASE WHEN tm.joined_at + make_interval(days => t.ramp_up_days) > NOW()
+		            THEN tm.joined_at + make_interval(days => t.ramp_up_days) END,
+		       u.timezone, u.work_start_minutes, u.work_end_minutes
+		FROM team_memberships tm
+		JOIN teams t ON t.team_id = tm.team_id
+		JOIN users u ON u.user_id = tm.user_id
+		WHERE tm.team_id = $1`
+
+var teamMemberSortColumns = map[string]string{
+	"username": "u.username",
+}
+
+func (r *Repository) scanTeamMember(rows pgx.Rows) (domain.TeamMember, error) {
+	var m domain.TeamMember
+	var rampUpUntil sql.NullTime
+	var timezone sql.NullString
+	var workStart, workEnd sql.NullInt16
+	if err := rows.Scan(&m.UserID, r.openText(&m.Username), &m.IsActive, &m.Seniority, &rampUpUntil, &timezone, &workStart, &workEnd); err != nil {
+		return domain.TeamMember{}, fmt.Errorf("scan team member: %w", err)
+	}
+	if rampUpUntil.Valid {
+		t := rampUpUntil.Time
+		m.RampUpUntil = &t
+	}
+	m.WorkingHours = workingHours(m.UserID, timezone, workStart, workEnd)
+	return m, nil
+}
+
+func (r *Repository) CountTeamMembers(ctx context.Context, teamID int64) (domain.TeamMemberCounts, error) {
+	var counts domain.TeamMemberCounts
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE u.is_active)
+		FROM team_memberships tm
+		JOIN users u ON u.user_id = tm.user_id
+		WHERE tm.team_id = $1
+	`, teamID).Scan(&counts.Total, &counts.Active)
+	if err != nil {
+		return domain.TeamMemberCounts{}, fmt.Errorf("count team members: %w", err)
+	}
+	return counts, nil
+}
+
+func (r *Repository) ListTeamMembersPage(ctx context.Context, teamID int64, page domain.Page) ([]domain.TeamMember, error) {
+	if r.fields != nil && page.SortBy == "username" {
+		members, err := r.listTeamMembersByTeamID(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		if page.Desc {
+			slices.Reverse(members)
+		}
+		members = members[min(page.Offset, len(members)):]
+		if page.Limit > 0 && len(members) > page.Limit {
+			members = members[:page.Limit]
+		}
+		return members, nil
+	}
+
+	order, err := pageClause(page, teamMemberSortColumns, "u.user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, teamMemberQuery+`
+		`+order, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("select team members page: %w", err)
+	}
+	defer rows.Close()
+
+	var members []domain.TeamMember
+	for rows.Next() {
+		m, err := r.scanTeamMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate team members page: %w", err)
+	}
+	return members, nil
+}
+
+func (r *Repository) EachTeamMember(ctx context.Context, teamID int64, fn func(domain.TeamMember) error) error {
+	if r.fields != nil {
+		members, err := r.listTeamMembersByTeamID(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		for _, m := range members {
+			if err := fn(m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rows, err := r.pool.Query(ctx, teamMemberQuery+`
+		ORDER BY u.username
+	`, teamID)
+	if err != nil {
+		return fmt.Errorf("select team members: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		m, err := r.scanTeamMember(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate team members: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+var teamSearchSortColumns = map[string]string{
+	"relevance": "score",
+	"name":      "t.team_name",
+	"size":      "COUNT(u.user_id)",
+}
+
+func (r *Repository) SearchTeams(ctx context.Context, filter domain.TeamSearchFilter, page domain.Page) ([]domain.TeamSearchResult, error) {
+	order, err := pageClause(page, teamSearchSortColumns, "t.team_name")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT t.team_name,
+		       COUNT(u.user_id),
+		       COUNT(u.user_id) FILTER (WHERE u.is_active),
+		       CASE WHEN $1::text = '' THEN 0
+		            ELSE GREATEST(similarity(lower(t.team_name), lower($1)), word_similarity(lower($1), lower(t.team_name)))
+		                 + CASE WHEN strpos(lower(t.team_name), lower($1)) > 0 THEN 1 ELSE 0 END
+		       END::float8 AS score
+		FROM teams t
+		LEFT JOIN team_memberships tm ON tm.team_id = t.team_id
+		LEFT JOIN users u ON u.user_id = tm.user_id
+		WHERE $1 = ''
+		   OR lower(t.team_name) % lower($1)
+		   OR lower($1) <% lower(t.team_name)
+		   OR strpos(lower(t.team_name), lower($1)) > 0
+		GROUP BY t.team_id, t.team_name
+		HAVING COUNT(u.user_id) >= $2
+		   AND ($3::boolean IS NULL OR (COUNT(u.user_id) FILTER (WHERE u.is_active) > 0) = $3)
+		`+order, filter.Query, filter.MinSize, filter.HasActiveMembers)
+	if err != nil {
+		return nil, fmt.Errorf("search teams: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.TeamSearchResult
+	for rows.Next() {
+		var t domain.TeamSearchResult
+		if err := rows.Scan(&t.Name, &t.Members, &t.ActiveMembers, &t.Score); err != nil {
+			return nil, fmt.Errorf("scan team search result: %w", err)
+		}
+		result = append(result, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate team search results: %w", err)
+	}
+	return result, nil
+}
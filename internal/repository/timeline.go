@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (r *Repository) ListPullRequestTimeline(ctx context.Context, prID string) ([]domain.TimelineEvent, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT at, kind, user_id, from_user_id, detail
+		FROM (
+			SELECT created_at AS at, 'created' AS kind, author_id AS user_id,
+			       NULL::TEXT AS from_user_id, NULL::TEXT AS detail, 0 AS rank
+			FROM pull_requests WHERE pull_request_id = $1
+			UNION ALL
+			SELECT assigned_at,
+			       CASE WHEN kind = 'shadow' THEN 'shadow_assigned'
+			            WHEN reassigned THEN 'reviewer_reassigned'
+			            ELSE 'reviewer_assigned' END,
+			       reviewer_id, handoff_from, handoff_note, 1
+			FROM pr_reviewers WHERE pull_request_id = $1
+			UNION ALL
+			SELECT declined_at, 'reviewer_declined', reviewer_id, NULL,
+			       COALESCE(reason, '') || CASE WHEN note <> '' THEN ': ' || note ELSE '' END, 1
+			FROM reviewer_declines WHERE pull_request_id = $1
+			UNION ALL
+			SELECT declared_at, 'conflict_declared', reviewer_id, NULL,
+			       category || CASE WHEN details <> '' THEN ': ' || details ELSE '' END, 1
+			FROM pr_reviewer_conflicts WHERE pull_request_id = $1
+			UNION ALL
+			SELECT l.created_at, 'blocked_by_linked', NULL, NULL, l.blocked_by_id, 2
+			FROM pr_links l WHERE l.pull_request_id = $1
+			UNION ALL
+			SELECT first_response_at, 'first_response', reviewer_id, NULL, NULL, 2
+			FROM pr_reviewers WHERE pull_request_id = $1 AND first_response_at IS NOT NULL
+			UNION ALL
+			SELECT approved_at, 'review_approved', reviewer_id, NULL, NULL, 2
+			FROM pr_reviews WHERE pull_request_id = $1
+			UNION ALL
+			SELECT c.checked_at, 'checklist_checked', c.checked_by, NULL, i.title, 2
+			FROM pr_checklist_checks c
+			JOIN team_checklist_items i ON i.item_id = c.item_id
+			WHERE c.pull_request_id = $1
+			UNION ALL
+			SELECT approvals_reset_at, 'approvals_reset', NULL, NULL, NULL, 2
+			FROM pull_requests WHERE pull_request_id = $1 AND approvals_reset_at IS NOT NULL
+			UNION ALL
+			SELECT merged_at, 'merged', merged_by, NULL, NULL, 3
+			FROM pull_requests WHERE pull_request_id = $1 AND merged_at IS NOT NULL
+			UNION ALL
+			SELECT deleted_at, 'deleted', deleted_by, NULL, NULL, 4
+			FROM pull_requests WHERE pull_request_id = $1 AND deleted_at IS NOT NULL
+		) events
+		ORDER BY at, rank, kind, user_id
+	`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("select pull request timeline: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.TimelineEvent
+	for rows.Next() {
+		var e domain.TimelineEvent
+		var userID, fromUserID, detail sql.NullString
+		if err := rows.Scan(&e.At, &e.Kind, &userID, &fromUserID, &detail); err != nil {
+			return nil, fmt.Errorf("scan timeline event: %w", err)
+		}
+		e.UserID, e.FromUserID, e.Detail = userID.String, fromUserID.String, detail.String
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate timeline events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) PatchUser(ctx context.Context, tx pgx.Tx, patch domain.UserPatch) (domain.User, domain.WorkingHours, error) {
+	if tx == nil {
+		return domain.User{}, domain.WorkingHours{}, errTxRequired
+	}
+
+	args := []any{patch.UserID, patch.ExpectedVersion}
+	var sets []string
+	set := func(format string, values ...any) {
+		placeholders := make([]any, len(values))
+		for i, v := range values {
+			args = append(args, v)
+			placeholders[i] = len(args)
+		}
+		sets = append(sets, fmt.Sprintf(format, placeholders...))
+	}
+
+	if patch.Has(domain.UserFieldUsername) {
+		username, err := r.sealText(patch.Username)
+		if err != nil {
+			return domain.User{}, domain.WorkingHours{}, err
+		}
+		set("username = $%d", username)
+	}
+	if patch.Has(domain.UserFieldIsActive) {
+		set("is_active = $%d", patch.IsActive)
+	}
+	if patch.Has(domain.UserFieldSeniority) {
+		set("seniority = $%d", string(patch.Seniority))
+	}
+	if patch.Has(domain.UserFieldTimezone) {
+		if patch.Timezone == "" {
+			sets = append(sets, "timezone = NULL, work_start_minutes = NULL, work_end_minutes = NULL")
+		} else {
+			set("timezone = $%d, work_start_minutes = COALESCE(work_start_minutes, $%d), work_end_minutes = COALESCE(work_end_minutes, $%d)",
+				patch.Timezone, int(domain.DefaultWorkStart/time.Minute), int(domain.DefaultWorkEnd/time.Minute))
+		}
+	}
+
+	var user domain.User
+	var teamID sql.NullInt64
+	var teamName, timezone sql.NullString
+	var workStart, workEnd sql.NullInt16
+	err := tx.QueryRow(ctx, `
+		WITH updated AS (
+			UPDATE users
+			SET `+strings.Join(sets, ", ")+`, updated_at = NOW()
+			WHERE user_id = $1 AND ($2::bigint IS NULL OR version = $2)
+			RETURNING user_id, username, is_active, seniority, version, timezone, work_start_minutes, work_end_minutes
+		)
+		SELECT u.user_id, u.username, u.is_active, u.seniority, u.version, u.timezone, u.work_start_minutes, u.work_end_minutes,
+		       tm.team_id, t.team_name
+		FROM updated u
+		LEFT JOIN team_memberships tm ON tm.user_id = u.user_id
+		LEFT JOIN teams t ON t.team_id = tm.team_id
+	`, args...).Scan(&user.ID, r.openText(&user.Username), &user.IsActive, &user.Seniority, &user.Version,
+		&timezone, &workStart, &workEnd, &teamID, &teamName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE user_id = $1)`, patch.UserID).Scan(&exists); err != nil {
+			return domain.User{}, domain.WorkingHours{}, fmt.Errorf("check user: %w", err)
+		}
+		if exists {
+			return domain.User{}, domain.WorkingHours{}, ErrUserVersionConflict
+		}
+		return domain.User{}, domain.WorkingHours{}, ErrUserNotFound
+	}
+	if err != nil {
+		return domain.User{}, domain.WorkingHours{}, fmt.Errorf("patch user: %w", err)
+	}
+
+	if teamID.Valid {
+		id := teamID.Int64
+		user.TeamID = &id
+	}
+	if teamName.Valid {
+		name := teamName.String
+		user.TeamName = &name
+	}
+	return user, workingHours(user.ID, timezone, workStart, workEnd), nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) GetWorkingHours(ctx context.Context, userID string) (domain.WorkingHours, error) {
+	var timezone sql.NullString
+	var workStart, workEnd sql.NullInt16
+	err := r.pool.QueryRow(ctx, `
+		SELECT timezone, work_start_minutes, work_end_minutes
+		FROM users
+		WHERE user_id = $1
+	`, userID).Scan(&timezone, &workStart, &workEnd)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.WorkingHours{}, ErrUserNotFound
+		}
+		return domain.WorkingHours{}, fmt.Errorf("select working hours: %w", err)
+	}
+	return workingHours(userID, timezone, workStart, workEnd), nil
+}
+
+func (r *Repository) SetWorkingHours(ctx context.Context, tx pgx.Tx, hours domain.WorkingHours) error {
+	if tx == nil {
+		return errTxRequired
+	}
+
+	var timezone *string
+	var workStart, workEnd *int
+	if hours.Known() {
+		start, end := int(hours.Start/time.Minute), int(hours.End/time.Minute)
+		timezone, workStart, workEnd = &hours.Timezone, &start, &end
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE users
+		SET timezone = $2, work_start_minutes = $3, work_end_minutes = $4
+		WHERE user_id = $1
+	`, hours.UserID, timezone, workStart, workEnd)
+	if err != nil {
+		return fmt.Errorf("update working hours: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func workingHours(userID string, timezone sql.NullString, workStart, workEnd sql.NullInt16) domain.WorkingHours {
+	if !timezone.Valid {
+		return domain.WorkingHours{UserID: userID}
+	}
+	return domain.WorkingHours{
+		UserID:   userID,
+		Timezone: timezone.String,
+		Start:    time.Duration(workStart.Int16) * time.Minute,
+		End:      time.Duration(workEnd.Int16) * time.Minute,
+	}
+}
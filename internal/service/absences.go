@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+type AbsenceSource interface {
+	Events(ctx context.Context) ([]domain.CalendarEvent, error)
+}
+
+func (s *AdminService) SyncAbsences(ctx context.Context) error {
+	if s.cfg.AbsenceSource == nil {
+		return nil
+	}
+
+	events, err := s.cfg.AbsenceSource.Events(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := s.now().UTC()
+	report := domain.AbsenceSyncReport{
+		RanAt:       now,
+		Events:      len(events),
+		Deactivated: []string{},
+		Restored:    []string{},
+		Issues:      []domain.AbsenceSyncIssue{},
+	}
+
+	candidates := make([]string, 0, len(events)*2)
+	for _, e := range events {
+		candidates = append(candidates, absenceUserCandidates(e)...)
+	}
+	known, err := s.repo.ListUserSeniorities(ctx, candidates)
+	if err != nil {
+		return err
+	}
+
+	absences := make([]domain.Absence, 0, len(events))
+	keep := make([]string, 0, len(events))
+	for _, e := range events {
+		if e.Cancelled {
+			continue
+		}
+		switch {
+		case e.UID == "":
+			report.Issues = append(report.Issues, domain.AbsenceSyncIssue{Message: "event " + e.Summary + " has no UID"})
+			continue
+		case e.StartsAt.IsZero() || !e.EndsAt.After(e.StartsAt):
+			report.Issues = append(report.Issues, domain.AbsenceSyncIssue{EventUID: e.UID, Message: "event has no valid start and end"})
+			continue
+		}
+
+		userID := ""
+		for _, candidate := range absenceUserCandidates(e) {
+			if _, ok := known[candidate]; ok {
+				userID = candidate
+				break
+			}
+		}
+		if userID == "" {
+			report.Issues = append(report.Issues, domain.AbsenceSyncIssue{EventUID: e.UID, Message: "no user matches organizer " + e.Organizer + " or summary " + e.Summary})
+			continue
+		}
+
+		keep = append(keep, e.UID)
+		absences = append(absences, domain.Absence{
+			UserID:     userID,
+			ExternalID: e.UID,
+			StartsAt:   e.StartsAt.UTC(),
+			EndsAt:     e.EndsAt.UTC(),
+		})
+	}
+	report.Absences = len(absences)
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.repo.UpsertCalendarAbsences(ctx, tx, absences); err != nil {
+			return err
+		}
+		cancelled, err := s.repo.EndMissingCalendarAbsences(ctx, tx, keep, now)
+		if err != nil {
+			return err
+		}
+		report.Cancelled = cancelled
+
+		if report.Deactivated, err = s.repo.ApplyDueAbsences(ctx, tx, now); err != nil {
+			return err
+		}
+		report.Restored, err = s.repo.RestoreEndedAbsences(ctx, tx, now)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if len(report.Deactivated) > 0 || len(report.Restored) > 0 {
+		s.members.invalidate()
+	}
+
+	s.absenceSync.Store(&report)
+	if len(report.Deactivated) > 0 || len(report.Restored) > 0 || len(report.Issues) > 0 {
+		s.logger.Info("absence calendar synced",
+			zap.Strings("deactivated", report.Deactivated),
+			zap.Strings("restored", report.Restored),
+			zap.Int("issues", len(report.Issues)),
+		)
+	}
+	return nil
+}
+
+func (s *AdminService) LastAbsenceSync() (domain.AbsenceSyncReport, bool) {
+	report := s.absenceSync.Load()
+	if report == nil {
+		return domain.AbsenceSyncReport{}, false
+	}
+	return *report, true
+}
+
+func absenceUserCandidates(e domain.CalendarEvent) []string {
+	var candidates []string
+	if local, _, ok := strings.Cut(e.Organizer, "@"); ok && local != "" {
+		candidates = append(candidates, domain.NormalizeID(local))
+	}
+	if prefix, _, ok := strings.Cut(e.Summary, ":"); ok && strings.TrimSpace(prefix) != "" {
+		candidates = append(candidates, domain.NormalizeID(prefix))
+	}
+	return candidates
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultAgingHistoryDays = 30
+	MaxAgingHistoryDays     = 365
+
+	agingOldestLimit = 10
+)
+
+func (s *StatsService) GetPullRequestAging(ctx context.Context, teamName string, days int) (domain.PullRequestAging, error) {
+	if days < 1 || days > MaxAgingHistoryDays {
+		return domain.PullRequestAging{}, &domain.ValidationError{Field: "days", Message: "must be between 1 and 365"}
+	}
+
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.PullRequestAging{}, err
+	}
+
+	now := s.now().UTC()
+	buckets, err := s.repo.CountTeamOpenPullRequestsByAge(ctx, team.ID, now)
+	if err != nil {
+		return domain.PullRequestAging{}, err
+	}
+	oldest, err := s.repo.ListOldestOpenPullRequests(ctx, team.ID, agingOldestLimit)
+	if err != nil {
+		return domain.PullRequestAging{}, err
+	}
+	history, err := s.repo.ListAgingSnapshots(ctx, team.ID, now.AddDate(0, 0, -(days-1)))
+	if err != nil {
+		return domain.PullRequestAging{}, err
+	}
+
+	return domain.PullRequestAging{
+		TeamName:    team.Name,
+		GeneratedAt: now,
+		Buckets:     buckets,
+		Oldest:      oldest,
+		History:     history,
+	}, nil
+}
+
+func (s *StatsService) SnapshotPullRequestAging(ctx context.Context) error {
+	var teams int64
+	err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var err error
+		teams, err = s.repo.SnapshotPullRequestAging(ctx, tx, s.now().UTC())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Debug("pull request aging snapshot taken", zap.Int64("teams", teams))
+	return nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const announcementsCacheTTL = 30 * time.Second
+
+type announcementCache struct {
+	mu       sync.Mutex
+	items    []domain.Announcement
+	loadedAt time.Time
+}
+
+func (s *AdminService) ListAnnouncements(ctx context.Context) ([]domain.Announcement, error) {
+	now := s.now().UTC()
+
+	s.announcements.mu.Lock()
+	defer s.announcements.mu.Unlock()
+
+	if s.announcements.loadedAt.IsZero() || now.Sub(s.announcements.loadedAt) >= announcementsCacheTTL {
+		items, err := s.repo.ListUnexpiredAnnouncements(ctx, now)
+		if err != nil {
+			return nil, err
+		}
+		s.announcements.items = items
+		s.announcements.loadedAt = now
+	}
+
+	active := make([]domain.Announcement, 0, len(s.announcements.items))
+	for _, a := range s.announcements.items {
+		if a.ActiveAt(now) {
+			active = append(active, a)
+		}
+	}
+	return active, nil
+}
+
+func (s *AdminService) CreateAnnouncement(ctx context.Context, message string, severity domain.AnnouncementSeverity, startsAt, endsAt *time.Time) (domain.Announcement, error) {
+	start := s.now().UTC()
+	if startsAt != nil {
+		start = startsAt.UTC()
+	}
+	draft, err := domain.NewAnnouncement(message, severity, start, endsAt)
+	if err != nil {
+		return domain.Announcement{}, err
+	}
+
+	var created domain.Announcement
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		created, err = s.repo.InsertAnnouncement(ctx, tx, draft)
+		return err
+	})
+	if err != nil {
+		return domain.Announcement{}, err
+	}
+
+	s.announcements.mu.Lock()
+	s.announcements.loadedAt = time.Time{}
+	s.announcements.mu.Unlock()
+
+	return created, nil
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *PullRequestService) InvalidateApprovals(ctx context.Context, prID string) (domain.PullRequest, []string, error) {
+	prID = domain.NormalizeID(prID)
+
+	pr, err := s.repo.GetPullRequest(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequest{}, nil, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, nil, err
+	}
+	if pr.Status == domain.PullRequestStatusMerged {
+		return domain.PullRequest{}, nil, ErrPullRequestMerged
+	}
+
+	now := s.now().UTC()
+	reviewDueAt := pr.ReviewDueAt
+	author, err := s.repo.GetUser(ctx, pr.AuthorID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return domain.PullRequest{}, nil, err
+	}
+	if err == nil && author.TeamID != nil {
+		if reviewDueAt, err = s.reviewDeadline(ctx, *author.TeamID, now); err != nil {
+			return domain.PullRequest{}, nil, err
+		}
+	}
+
+	var reset []string
+	var updated domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		reset, err = s.repo.ResetApprovals(ctx, tx, prID, reviewDueAt, now)
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return ErrPullRequestMerged
+		}
+		if err != nil {
+			return err
+		}
+		updated, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, nil, err
+	}
+	s.pullRequests.invalidate(prID)
+	slices.Sort(reset)
+
+	s.logger.Info("pull request approvals invalidated",
+		zap.String("pull_request_id", prID),
+		zap.Strings("reviewers", pr.Reviewers),
+		zap.Strings("reset_reviewers", reset),
+	)
+
+	return updated, reset, nil
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *base) assignmentFrozen(ctx context.Context) (bool, error) {
+	return s.repo.IsAssignmentFrozen(ctx, s.now().UTC())
+}
+
+func (s *AdminService) ListAssignmentFreezes(ctx context.Context) ([]domain.AssignmentFreeze, error) {
+	return s.repo.ListAssignmentFreezes(ctx, s.now().UTC())
+}
+
+func (s *AdminService) CreateAssignmentFreeze(ctx context.Context, reason string, startsAt *time.Time, endsAt time.Time) (domain.AssignmentFreeze, error) {
+	start := s.now().UTC()
+	if startsAt != nil {
+		start = startsAt.UTC()
+	}
+	draft, err := domain.NewAssignmentFreeze(reason, start, endsAt.UTC())
+	if err != nil {
+		return domain.AssignmentFreeze{}, err
+	}
+
+	var created domain.AssignmentFreeze
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		created, err = s.repo.InsertAssignmentFreeze(ctx, tx, draft)
+		return err
+	})
+	if err != nil {
+		return domain.AssignmentFreeze{}, err
+	}
+
+	s.logger.Info("assignment freeze scheduled",
+		zap.Int64("freeze_id", created.ID),
+		zap.Time("starts_at", created.StartsAt),
+		zap.Time("ends_at", created.EndsAt),
+	)
+	return created, nil
+}
+
+func (s *AdminService) LiftAssignmentFreeze(ctx context.Context, freezeID int64) (domain.AssignmentFreeze, error) {
+	var lifted domain.AssignmentFreeze
+	err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var err error
+		lifted, err = s.repo.LiftAssignmentFreeze(ctx, tx, freezeID, s.now().UTC())
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrFreezeNotFound) {
+			return domain.AssignmentFreeze{}, ErrFreezeNotFound
+		}
+		return domain.AssignmentFreeze{}, err
+	}
+
+	s.logger.Info("assignment freeze lifted", zap.Int64("freeze_id", lifted.ID))
+	return lifted, nil
+}
+
+func (s *PullRequestService) createDeferredPullRequest(ctx context.Context, prID, prName, authorID string, linesChanged *int) (domain.PullRequest, error) {
+	author, err := s.repo.GetUser(ctx, authorID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.PullRequest{}, ErrUserNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if author.TeamID == nil {
+		return domain.PullRequest{}, ErrTeamNotFound
+	}
+
+	deferredAt := s.now().UTC()
+	var created domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := s.repo.CreatePullRequest(ctx, tx, domain.PullRequest{
+			ID:                   prID,
+			Name:                 prName,
+			AuthorID:             authorID,
+			Status:               domain.PullRequestStatusOpen,
+			LinesChanged:         linesChanged,
+			AssignmentDeferredAt: &deferredAt,
+		})
+		if err != nil {
+			if errors.Is(err, repository.ErrPullRequestExists) {
+				return ErrPullRequestExists
+			}
+			if errors.Is(err, repository.ErrDuplicatePullRequest) {
+				return ErrDuplicatePullRequest
+			}
+			return err
+		}
+		created, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if errors.Is(err, ErrDuplicatePullRequest) {
+		return domain.PullRequest{}, s.duplicatePullRequestError(ctx, authorID, prName)
+	}
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	s.logger.Info("reviewer assignment deferred by freeze", zap.String("pull_request_id", prID))
+	return created, nil
+}
+
+func (s *PullRequestService) AssignDeferredReviews(ctx context.Context) error {
+	frozen, err := s.assignmentFrozen(ctx)
+	if err != nil || frozen {
+		return err
+	}
+
+	prIDs, err := s.repo.ListDeferredPullRequests(ctx)
+	if err != nil {
+		return err
+	}
+
+	var assigned int
+	for _, prID := range prIDs {
+		ok, err := s.assignDeferred(ctx, prID)
+		if err != nil {
+			s.logger.Warn("deferred reviewer assignment failed", zap.String("pull_request_id", prID), zap.Error(err))
+			continue
+		}
+		if ok {
+			assigned++
+		}
+	}
+	if assigned > 0 {
+		s.logger.Info("deferred reviewers assigned", zap.Int("pull_requests", assigned))
+	}
+	return nil
+}
+
+func (s *PullRequestService) assignDeferred(ctx context.Context, prID string) (bool, error) {
+	pr, err := s.repo.GetPullRequest(ctx, prID)
+	if err != nil {
+		return false, err
+	}
+	author, exclude, err := s.authorForAssignment(ctx, pr.AuthorID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return false, err
+	}
+
+	var reviewDueAt *time.Time
+	if author.TeamID != nil {
+		if reviewDueAt, err = s.reviewDeadline(ctx, *author.TeamID, s.now().UTC()); err != nil {
+			return false, err
+		}
+	}
+
+	var cleared bool
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		cleared, err = s.repo.ClearAssignmentDeferred(ctx, tx, prID, reviewDueAt)
+		if err != nil || !cleared || author.TeamID == nil {
+			return err
+		}
+
+		reviewerIDs, err := s.selectReviewers(ctx, *author.TeamID, author.ID, exclude)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.AddReviewers(ctx, tx, prID, reviewerIDs); err != nil {
+			return err
+		}
+
+		shadowID, err := s.selectShadowReviewer(ctx, *author.TeamID, exclude, reviewerIDs)
+		if err != nil || shadowID == "" {
+			return err
+		}
+		return s.repo.AddShadowReviewer(ctx, tx, prID, shadowID)
+	})
+	if err != nil {
+		return false, err
+	}
+	s.pullRequests.invalidate(prID)
+	return cleared, nil
+}
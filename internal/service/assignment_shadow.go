@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const shadowReportRecentLimit = 50
+
+type shadowCounters struct {
+	samples  atomic.Int64
+	diverged atomic.Int64
+}
+
+func (s *PullRequestService) shadowAssignment(ctx context.Context, prID string, teamID int64, exclude, primary []string) *domain.AssignmentShadowSample {
+	candidates, err := s.repo.ListLeastLoadedActiveTeamMembers(ctx, teamID, exclude, len(primary))
+	if err != nil {
+		s.logger.Warn("shadow assignment failed", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil
+	}
+
+	shadowIDs := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		shadowIDs = append(shadowIDs, c.UserID)
+	}
+
+	sample := &domain.AssignmentShadowSample{
+		PullRequestID:    prID,
+		PrimaryReviewers: primary,
+		ShadowReviewers:  shadowIDs,
+		Diverged:         !sameMembers(primary, shadowIDs),
+	}
+	if sample.Diverged {
+		s.logger.Info("shadow assignment diverged",
+			zap.String("pull_request_id", prID),
+			zap.Strings("primary_reviewers", primary),
+			zap.Strings("shadow_reviewers", shadowIDs),
+		)
+	}
+
+	return sample
+}
+
+func (s *PullRequestService) recordShadowAssignment(ctx context.Context, teamID int64, sample domain.AssignmentShadowSample) {
+	err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.InsertAssignmentShadow(ctx, tx, teamID, sample)
+	})
+	if err != nil {
+		s.logger.Warn("record shadow assignment failed", zap.String("pull_request_id", sample.PullRequestID), zap.Error(err))
+		return
+	}
+
+	s.shadows.samples.Add(1)
+	if sample.Diverged {
+		s.shadows.diverged.Add(1)
+	}
+}
+
+func (s *AdminService) ShadowAssignmentStats() (int64, int64) {
+	return s.shadows.samples.Load(), s.shadows.diverged.Load()
+}
+
+func (s *StatsService) GetAssignmentShadowReport(ctx context.Context, teamName string) (domain.AssignmentShadowReport, error) {
+	var teamID *int64
+	if teamName != "" {
+		team, err := s.getTeam(ctx, teamName)
+		if err != nil {
+			return domain.AssignmentShadowReport{}, err
+		}
+		teamID = &team.ID
+	}
+
+	return s.repo.GetAssignmentShadowReport(ctx, teamID, shadowReportRecentLimit)
+}
+
+func sameMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+	for _, id := range b {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
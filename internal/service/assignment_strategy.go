@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+type AssignmentStrategy interface {
+	Order(ctx context.Context, teamID int64, candidates []domain.TeamMember) ([]domain.TeamMember, error)
+	Assigned(ctx context.Context, teamID int64, picked []domain.TeamMember) error
+}
+
+type randomStrategy struct{}
+
+func (randomStrategy) Order(_ context.Context, _ int64, candidates []domain.TeamMember) ([]domain.TeamMember, error) {
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	return candidates, nil
+}
+
+func (randomStrategy) Assigned(context.Context, int64, []domain.TeamMember) error {
+	return nil
+}
+
+var errRoundRobinTxRequired = errors.New("round-robin assignment must run inside a transaction")
+
+// roundRobinStrategy walks the team in user ID order, starting after the
+// last user the team's cursor points at.
+type roundRobinStrategy struct {
+	repo       *repository.Repository
+	lastUserID string
+}
+
+func (s *roundRobinStrategy) Order(ctx context.Context, teamID int64, candidates []domain.TeamMember) ([]domain.TeamMember, error) {
+	tx, ok := ctxutil.Tx(ctx)
+	if !ok {
+		return nil, errRoundRobinTxRequired
+	}
+	lastUserID, err := s.repo.LockAssignmentCursor(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	s.lastUserID = lastUserID
+
+	slices.SortFunc(candidates, func(a, b domain.TeamMember) int {
+		return strings.Compare(a.UserID, b.UserID)
+	})
+	next := slices.IndexFunc(candidates, func(m domain.TeamMember) bool {
+		return m.UserID > lastUserID
+	})
+	if next <= 0 {
+		return candidates, nil
+	}
+	return append(candidates[next:len(candidates):len(candidates)], candidates[:next]...), nil
+}
+
+// Assigned moves the cursor to the picked member furthest along the rotation.
+// The picked list may be reordered by timezone and ramp-up preferences, so its
+// last element is not necessarily the furthest one.
+func (s *roundRobinStrategy) Assigned(ctx context.Context, teamID int64, picked []domain.TeamMember) error {
+	if len(picked) == 0 {
+		return nil
+	}
+	tx, ok := ctxutil.Tx(ctx)
+	if !ok {
+		return errRoundRobinTxRequired
+	}
+	return s.repo.SetAssignmentCursor(ctx, tx, teamID, furthestInRotation(s.lastUserID, picked))
+}
+
+func furthestInRotation(lastUserID string, picked []domain.TeamMember) string {
+	wrapped := func(id string) bool { return id <= lastUserID }
+	furthest := picked[0].UserID
+	for _, m := range picked[1:] {
+		id := m.UserID
+		if a, b := wrapped(id), wrapped(furthest); a != b {
+			if a {
+				furthest = id
+			}
+			continue
+		}
+		if id > furthest {
+			furthest = id
+		}
+	}
+	return furthest
+}
+
+func (s *base) assignmentStrategy(ctx context.Context, teamID int64) (AssignmentStrategy, error) {
+	kind, err := s.repo.GetTeamAssignmentStrategy(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if kind == "" {
+		kind = s.cfg.AssignmentStrategy
+	}
+
+	if kind == domain.AssignmentStrategyRoundRobin {
+		return &roundRobinStrategy{repo: s.repo}, nil
+	}
+	return randomStrategy{}, nil
+}
+
+func (s *TeamService) SetTeamAssignmentStrategy(ctx context.Context, teamName string, strategy domain.AssignmentStrategyKind) (domain.Team, error) {
+	if err := domain.ValidateAssignmentStrategy(strategy); err != nil {
+		return domain.Team{}, err
+	}
+
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.SetTeamAssignmentStrategy(ctx, tx, team.ID, strategy)
+	})
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	return s.getTeam(ctx, teamName)
+}
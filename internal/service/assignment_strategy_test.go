@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func TestFurthestInRotation(t *testing.T) {
+	members := func(ids ...string) []domain.TeamMember {
+		out := make([]domain.TeamMember, len(ids))
+		for i, id := range ids {
+			out[i] = domain.TeamMember{UserID: id}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name   string
+		last   string
+		picked []domain.TeamMember
+		want   string
+	}{
+		{name: "empty cursor", last: "", picked: members("u3", "u1"), want: "u3"},
+		{name: "preference reordered the pick", last: "u2", picked: members("u5", "u3"), want: "u5"},
+		{name: "pick wrapped past the end", last: "u4", picked: members("u1", "u5"), want: "u1"},
+		{name: "wrapped picks", last: "u4", picked: members("u2", "u1"), want: "u2"},
+		{name: "cursor member picked again", last: "u4", picked: members("u4", "u5"), want: "u4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := furthestInRotation(tt.last, tt.picked); got != tt.want {
+				t.Errorf("furthestInRotation(%q, %v) = %q, want %q", tt.last, tt.picked, got, tt.want)
+			}
+		})
+	}
+}
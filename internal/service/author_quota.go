@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+)
+
+type AuthorRateLimitError struct {
+	Limit   int
+	Used    int
+	ResetAt time.Time
+}
+
+func (e *AuthorRateLimitError) Error() string {
+	return fmt.Sprintf("%s: %d of %d pull requests opened today, resets at %s",
+		ErrAuthorRateLimited, e.Used, e.Limit, e.ResetAt.Format(time.RFC3339))
+}
+
+func (e *AuthorRateLimitError) Unwrap() error {
+	return ErrAuthorRateLimited
+}
+
+func (s *PullRequestService) checkAuthorQuota(ctx context.Context, authorID string) error {
+	if s.cfg.AuthorDailyPullRequests == 0 || ctxutil.CallerFrom(ctx).Trusted {
+		return nil
+	}
+
+	now := s.now().UTC()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	used, err := s.repo.CountPullRequestsByAuthorSince(ctx, authorID, dayStart)
+	if err != nil {
+		return err
+	}
+	if used < s.cfg.AuthorDailyPullRequests {
+		return nil
+	}
+
+	return &AuthorRateLimitError{
+		Limit:   s.cfg.AuthorDailyPullRequests,
+		Used:    used,
+		ResetAt: dayStart.AddDate(0, 0, 1),
+	}
+}
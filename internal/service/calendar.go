@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *TeamService) GetTeamCalendar(ctx context.Context, teamName string) (domain.TeamCalendar, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.TeamCalendar{}, err
+	}
+
+	cal, isDefault, err := s.calendarForTeam(ctx, team.ID)
+	if err != nil {
+		return domain.TeamCalendar{}, err
+	}
+
+	return domain.TeamCalendar{TeamName: team.Name, Calendar: cal, IsDefault: isDefault}, nil
+}
+
+func (s *TeamService) SetTeamCalendar(ctx context.Context, teamName string, cal domain.Calendar) (domain.TeamCalendar, error) {
+	if err := cal.Validate(); err != nil {
+		return domain.TeamCalendar{}, fmt.Errorf("%w: %v", ErrInvalidCalendar, err)
+	}
+
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.TeamCalendar{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.UpsertTeamCalendar(ctx, tx, team.ID, cal)
+	})
+	if err != nil {
+		return domain.TeamCalendar{}, err
+	}
+
+	return domain.TeamCalendar{TeamName: team.Name, Calendar: cal}, nil
+}
+
+func (s *base) calendarForTeam(ctx context.Context, teamID int64) (domain.Calendar, bool, error) {
+	cal, err := s.repo.GetTeamCalendar(ctx, teamID)
+	if errors.Is(err, repository.ErrCalendarNotFound) {
+		return s.cfg.DefaultCalendar, true, nil
+	}
+	if err != nil {
+		return domain.Calendar{}, false, err
+	}
+	return cal, false, nil
+}
+
+func (s *PullRequestService) reviewDeadline(ctx context.Context, teamID int64, from time.Time) (*time.Time, error) {
+	policies, err := s.resolvePolicies(ctx, teamID, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	sla := policies.Get(domain.PolicyReviewSLA).Duration()
+	if sla <= 0 {
+		return nil, nil
+	}
+
+	cal, _, err := s.calendarForTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	due, err := cal.AddBusinessTime(from, sla)
+	if err != nil {
+		s.logger.Warn("review deadline not computed", zap.Int64("team_id", teamID), zap.Error(err))
+		return nil, nil
+	}
+	due = due.UTC()
+	return &due, nil
+}
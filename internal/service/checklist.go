@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *TeamService) SetTeamChecklist(ctx context.Context, teamName string, titles []string, required bool) (domain.Team, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.ReplaceChecklist(ctx, tx, team.ID, titles, required)
+	})
+	if err != nil {
+		return domain.Team{}, err
+	}
+	s.pullRequests.invalidateAll()
+
+	return s.getTeam(ctx, teamName)
+}
+
+func (s *PullRequestService) SetChecklistItem(ctx context.Context, prID, reviewerID string, itemID int64, checked bool) (domain.PullRequest, error) {
+	pr, err := s.repo.GetPullRequest(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if pr.Status == domain.PullRequestStatusMerged {
+		return domain.PullRequest{}, ErrPullRequestMerged
+	}
+	if !containsString(pr.Reviewers, reviewerID) {
+		return domain.PullRequest{}, ErrReviewerNotAssigned
+	}
+
+	found := false
+	for _, item := range pr.Checklist {
+		if item.ItemID == itemID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return domain.PullRequest{}, ErrChecklistItemNotFound
+	}
+
+	var updated domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		locked, err := s.repo.LockPullRequest(ctx, tx, prID)
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return ErrPullRequestNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if locked.Status == domain.PullRequestStatusMerged {
+			return ErrPullRequestMerged
+		}
+		if checked {
+			if err := s.repo.CheckChecklistItem(ctx, tx, prID, itemID, reviewerID); err != nil {
+				if errors.Is(err, repository.ErrChecklistItemNotFound) {
+					return ErrChecklistItemNotFound
+				}
+				return err
+			}
+		} else if err := s.repo.UncheckChecklistItem(ctx, tx, prID, itemID); err != nil {
+			return err
+		}
+		if err := s.repo.MarkReviewerResponded(ctx, tx, prID, reviewerID, s.now().UTC()); err != nil {
+			return err
+		}
+		updated, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	s.pullRequests.invalidate(prID)
+
+	return updated, nil
+}
+
+func (s *PullRequestService) ensureChecklistComplete(ctx context.Context, pr domain.PullRequest) error {
+	required, err := s.repo.IsChecklistRequired(ctx, pr.ID)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return nil
+	}
+
+	for _, item := range pr.Checklist {
+		if item.CheckedBy == nil {
+			return ErrChecklistIncomplete
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+type IDGenerator interface {
+	NewID() string
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type uuidGenerator struct{}
+
+func (uuidGenerator) NewID() string {
+	return domain.NewUUID()
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *PullRequestService) DeclareConflict(ctx context.Context, prID, reviewerID string, category domain.ConflictCategory, details string) (domain.PullRequest, domain.ConflictDeclaration, error) {
+	declaration, err := domain.NewConflictDeclaration(prID, reviewerID, category, details)
+	if err != nil {
+		return domain.PullRequest{}, domain.ConflictDeclaration{}, err
+	}
+
+	declaration.DeclaredAt = s.now().UTC()
+
+	var updated domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		pr, err := s.repo.GetPullRequestTx(ctx, tx, declaration.PullRequestID, false)
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return ErrPullRequestNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if declaration.ReplacedBy, err = s.pickReplacement(ctx, pr, declaration.ReviewerID); err != nil {
+			return err
+		}
+
+		if err := s.repo.ReplaceReviewer(ctx, tx, pr.ID, declaration.ReviewerID, declaration.ReplacedBy, ""); err != nil {
+			if errors.Is(err, repository.ErrReviewerNotAssigned) {
+				return ErrReviewerNotAssigned
+			}
+			if errors.Is(err, repository.ErrReviewerAlreadyAssigned) {
+				return ErrReviewerAlreadyAssigned
+			}
+			return err
+		}
+		if err := s.repo.RecordReviewerConflict(ctx, tx, declaration); err != nil {
+			return err
+		}
+		if err := s.repo.RecordReviewerDecline(ctx, tx, domain.ReviewerDecline{
+			PullRequestID: declaration.PullRequestID,
+			ReviewerID:    declaration.ReviewerID,
+			Reason:        domain.DeclineConflict,
+			Note:          declaration.Details,
+			ReplacedBy:    declaration.ReplacedBy,
+			DeclinedAt:    declaration.DeclaredAt,
+		}); err != nil {
+			return err
+		}
+		updated, err = s.repo.GetPullRequestTx(ctx, tx, pr.ID, false)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, domain.ConflictDeclaration{}, err
+	}
+	s.pullRequests.invalidate(updated.ID)
+
+	s.logger.Info("reviewer conflict declared",
+		zap.String("pull_request_id", updated.ID),
+		zap.String("reviewer_id", declaration.ReviewerID),
+		zap.String("category", string(declaration.Category)),
+		zap.String("replaced_by", declaration.ReplacedBy),
+	)
+
+	return updated, declaration, nil
+}
+
+func (s *AdminService) ListReviewerConflicts(ctx context.Context, page domain.Page) ([]domain.ConflictDeclaration, error) {
+	return s.repo.ListReviewerConflicts(ctx, page)
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+var consistencyFixes = map[domain.ConsistencyCheck]string{
+	domain.ConsistencyReviewerInactive:   "reassign the reviewer via POST /pullRequest/reassign or reactivate the user",
+	domain.ConsistencyReviewerNotInTeam:  "reassign the reviewer via POST /pullRequest/reassign or restore the team membership",
+	domain.ConsistencyAuthorIsReviewer:   "replace the author via POST /pullRequest/reassign",
+	domain.ConsistencyMergedWithoutTime:  "set merged_at to the actual merge time",
+	domain.ConsistencyOpenWithMergeTime:  "clear merged_at or merge the pull request via POST /pullRequest/merge",
+	domain.ConsistencyOrphanedMembership: "delete the membership row or recreate the referenced team/user",
+}
+
+func (s *AdminService) CheckConsistency(ctx context.Context) ([]domain.ConsistencyViolation, error) {
+	violations, err := s.repo.ListConsistencyViolations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range violations {
+		violations[i].SuggestedFix = consistencyFixes[violations[i].Check]
+	}
+	return violations, nil
+}
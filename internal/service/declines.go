@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (s *StatsService) GetDeclineReasonStats(ctx context.Context, teamName string, period time.Duration) (domain.DeclineReasonStats, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.DeclineReasonStats{}, err
+	}
+
+	var since *time.Time
+	if period > 0 {
+		t := s.now().UTC().Add(-period)
+		since = &t
+	}
+
+	counts, err := s.repo.CountDeclineReasons(ctx, team.ID, since)
+	if err != nil {
+		return domain.DeclineReasonStats{}, err
+	}
+
+	stats := domain.DeclineReasonStats{TeamName: team.Name, Unspecified: counts[""]}
+	stats.Total = stats.Unspecified
+	for _, reason := range domain.DeclineReasons {
+		stats.Reasons = append(stats.Reasons, domain.DeclineReasonCount{Reason: reason, Count: counts[reason]})
+		stats.Total += counts[reason]
+	}
+	return stats, nil
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *TeamService) SetUniqueOpenPRNames(ctx context.Context, teamName string, enabled bool) (domain.Team, []domain.PullRequestNameConflict, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, nil, err
+	}
+
+	var conflicts []domain.PullRequestNameConflict
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		conflicts, err = s.repo.SetUniqueOpenPRNames(ctx, tx, team.ID, enabled)
+		return err
+	})
+	if err != nil {
+		return domain.Team{}, nil, err
+	}
+
+	team, err = s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, nil, err
+	}
+	return team, conflicts, nil
+}
+
+func (s *PullRequestService) duplicatePullRequestError(ctx context.Context, authorID, name string) error {
+	existing, err := s.repo.FindOpenPullRequestByName(ctx, authorID, name)
+	if err != nil {
+		return ErrDuplicatePullRequest
+	}
+	return fmt.Errorf("%w: %s", ErrDuplicatePullRequest, existing)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *AdminService) ListReviewerExclusions(ctx context.Context) ([]domain.ReviewerExclusion, error) {
+	return s.repo.ListReviewerExclusions(ctx)
+}
+
+func (s *AdminService) AddReviewerExclusion(ctx context.Context, userA, userB, reason string) (domain.ReviewerExclusion, error) {
+	exclusion, err := domain.NewReviewerExclusion(userA, userB, reason)
+	if err != nil {
+		return domain.ReviewerExclusion{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		exclusion, err = s.repo.UpsertReviewerExclusion(ctx, tx, exclusion)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return domain.ReviewerExclusion{}, err
+	}
+
+	return exclusion, nil
+}
+
+func (s *AdminService) RemoveReviewerExclusion(ctx context.Context, userA, userB string) error {
+	exclusion, err := domain.NewReviewerExclusion(userA, userB, "")
+	if err != nil {
+		return err
+	}
+
+	return s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err := s.repo.DeleteReviewerExclusion(ctx, tx, exclusion.UserA, exclusion.UserB)
+		if errors.Is(err, repository.ErrExclusionNotFound) {
+			return ErrExclusionNotFound
+		}
+		return err
+	})
+}
+
+// authorForAssignment loads the author together with the reviewers they must
+// never be paired with, in one round-trip.
+func (s *PullRequestService) authorForAssignment(ctx context.Context, authorID string) (domain.User, []string, error) {
+	author, excluded, err := s.repo.GetUserWithExclusions(ctx, authorID)
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	return author, append([]string{author.ID}, excluded...), nil
+}
+
+func (s *PullRequestService) assignmentExclusions(ctx context.Context, authorID string, assigned ...[]string) ([]string, error) {
+	excluded, err := s.repo.ListExcludedReviewers(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude := append([]string{authorID}, excluded...)
+	for _, ids := range assigned {
+		exclude = append(exclude, ids...)
+	}
+	return exclude, nil
+}
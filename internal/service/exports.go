@@ -0,0 +1,113 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/logger"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const maxExportDaysPerRun = 31
+
+type ArchiveStore interface {
+	Put(ctx context.Context, key, contentType string, body []byte) error
+}
+
+type reviewerHistoryLine struct {
+	PullRequestID   string     `json:"pull_request_id"`
+	AuthorID        string     `json:"author_id"`
+	ReviewerID      string     `json:"reviewer_id"`
+	Kind            string     `json:"kind"`
+	Reassigned      bool       `json:"reassigned"`
+	HandoffFrom     string     `json:"handoff_from,omitempty"`
+	AssignedAt      time.Time  `json:"assigned_at"`
+	FirstResponseAt *time.Time `json:"first_response_at,omitempty"`
+}
+
+func (s *AdminService) ExportHistory(ctx context.Context) error {
+	if s.cfg.ArchiveStore == nil {
+		return nil
+	}
+
+	next, err := s.repo.NextHistoryExportDate(ctx, domain.ExportKindReviewerHistory)
+	if err != nil || next == nil {
+		return err
+	}
+
+	now := s.now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	exported := 0
+	for day := *next; day.Before(today) && exported < maxExportDaysPerRun; day = day.AddDate(0, 0, 1) {
+		if err := s.exportReviewerHistoryDay(ctx, day); err != nil {
+			return err
+		}
+		exported++
+	}
+
+	if exported > 0 {
+		s.logger.Info("history exported", zap.String("kind", domain.ExportKindReviewerHistory), zap.Int("days", exported))
+	}
+	return nil
+}
+
+func (s *AdminService) exportReviewerHistoryDay(ctx context.Context, day time.Time) error {
+	records, err := s.repo.ListReviewerHistory(ctx, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+	scrub, err := logger.NewScrubber(s.cfg.PIIMode, s.cfg.PIIKey)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	for _, rec := range records {
+		if rec.HandoffFrom != "" {
+			rec.HandoffFrom = scrub(rec.HandoffFrom)
+		}
+		if err := enc.Encode(reviewerHistoryLine{
+			PullRequestID:   rec.PullRequestID,
+			AuthorID:        scrub(rec.AuthorID),
+			ReviewerID:      scrub(rec.ReviewerID),
+			Kind:            string(rec.Kind),
+			Reassigned:      rec.Reassigned,
+			HandoffFrom:     rec.HandoffFrom,
+			AssignedAt:      rec.AssignedAt.UTC(),
+			FirstResponseAt: rec.FirstResponseAt,
+		}); err != nil {
+			return fmt.Errorf("encode reviewer history: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("compress reviewer history: %w", err)
+	}
+
+	export := domain.HistoryExport{
+		Kind:      domain.ExportKindReviewerHistory,
+		Date:      day,
+		ObjectKey: fmt.Sprintf("%s/%s.jsonl.gz", domain.ExportKindReviewerHistory, day.Format("2006/01/02")),
+		Rows:      len(records),
+		Bytes:     buf.Len(),
+	}
+	if err := s.cfg.ArchiveStore.Put(ctx, export.ObjectKey, "application/gzip", buf.Bytes()); err != nil {
+		return err
+	}
+
+	export.ExportedAt = s.now().UTC()
+	return s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.RecordHistoryExport(ctx, tx, export)
+	})
+}
+
+func (s *AdminService) ListHistoryExports(ctx context.Context) ([]domain.HistoryExport, error) {
+	return s.repo.ListHistoryExports(ctx)
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *UserService) GetFocusSchedule(ctx context.Context, userID string) (domain.FocusSchedule, error) {
+	userID = domain.NormalizeID(userID)
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.FocusSchedule{}, ErrUserNotFound
+		}
+		return domain.FocusSchedule{}, err
+	}
+	return s.repo.GetFocusSchedule(ctx, userID)
+}
+
+func (s *UserService) SetFocusSchedule(ctx context.Context, userID, timezone string, windows []domain.FocusWindow) (domain.FocusSchedule, error) {
+	schedule, err := domain.NewFocusSchedule(userID, timezone, windows)
+	if err != nil {
+		return domain.FocusSchedule{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if _, err := s.repo.GetUser(ctx, schedule.UserID); err != nil {
+			return err
+		}
+		return s.repo.ReplaceFocusSchedule(ctx, tx, schedule)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.FocusSchedule{}, ErrUserNotFound
+		}
+		return domain.FocusSchedule{}, err
+	}
+
+	return schedule, nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+const forecastHistoryWeeks = 4
+
+func (s *StatsService) GetReviewForecast(ctx context.Context, teamName string) (domain.ReviewForecast, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.ReviewForecast{}, err
+	}
+
+	now := s.now().UTC()
+	since := now.AddDate(0, 0, -7*forecastHistoryWeeks)
+
+	created, err := s.repo.CountTeamPullRequestsInLastWeeks(ctx, team.ID, now, forecastHistoryWeeks)
+	if err != nil {
+		return domain.ReviewForecast{}, err
+	}
+	loads, err := s.repo.ListReviewerLoad(ctx, team.ID, since, now)
+	if err != nil {
+		return domain.ReviewForecast{}, err
+	}
+
+	policies, err := s.resolvePolicies(ctx, team.ID, "", team.Quorum)
+	if err != nil {
+		return domain.ReviewForecast{}, err
+	}
+
+	forecast := domain.ReviewForecast{
+		HistoryWeeks:       forecastHistoryWeeks,
+		WeeklyPullRequests: float64(created) / forecastHistoryWeeks,
+		ReviewersPerPR:     policies.Get(domain.PolicyReviewersPerPR).Int(),
+		Members:            loads,
+		Warnings:           []string{},
+	}
+
+	active := 0
+	for _, l := range loads {
+		if l.IsActive {
+			active++
+		}
+	}
+	if active <= forecast.ReviewersPerPR {
+		forecast.Warnings = append(forecast.Warnings, fmt.Sprintf(
+			"only %d active member(s); each pull request needs %d reviewer(s) besides the author",
+			active, forecast.ReviewersPerPR))
+	}
+
+	newAssignments := forecast.WeeklyPullRequests * float64(forecast.ReviewersPerPR)
+	overdue := 0
+	for i := range forecast.Members {
+		l := &forecast.Members[i]
+		l.WeeklyCapacity = float64(l.HandledReviews) / forecastHistoryWeeks
+		l.ProjectedLoad = float64(l.PendingReviews)
+		if l.IsActive && active > 0 {
+			l.ProjectedLoad += newAssignments / float64(active)
+		}
+		l.LikelySLABreach = l.ProjectedLoad > l.WeeklyCapacity
+		if l.LikelySLABreach {
+			forecast.Warnings = append(forecast.Warnings, fmt.Sprintf(
+				"%s is projected to have %.1f review(s) next week but handled %.1f per week",
+				l.UserID, l.ProjectedLoad, l.WeeklyCapacity))
+		}
+		overdue += l.OverdueReviews
+	}
+	if overdue > 0 {
+		forecast.Warnings = append(forecast.Warnings, fmt.Sprintf(
+			"%d review(s) are already past their SLA deadline", overdue))
+	}
+
+	return forecast, nil
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (s *AdminService) CollectInvariants(ctx context.Context) error {
+	gauges, err := s.repo.CountInvariantViolations(ctx)
+	if err != nil {
+		return err
+	}
+	gauges.CollectedAt = s.now().UTC()
+	s.invariants.Store(&gauges)
+	return nil
+}
+
+func (s *AdminService) InvariantGauges() (domain.InvariantGauges, bool) {
+	gauges := s.invariants.Load()
+	if gauges == nil {
+		return domain.InvariantGauges{}, false
+	}
+	return *gauges, true
+}
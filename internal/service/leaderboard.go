@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+)
+
+func (s *StatsService) GetLeaderboard(ctx context.Context, teamName string, period time.Duration) ([]domain.LeaderboardEntry, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	var since *time.Time
+	if period > 0 {
+		t := s.now().UTC().Add(-period)
+		since = &t
+	}
+
+	entries, err := s.repo.ListLeaderboard(ctx, team.ID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.CompletedReviews != b.CompletedReviews {
+			return a.CompletedReviews > b.CompletedReviews
+		}
+		if a.Saves != b.Saves {
+			return a.Saves > b.Saves
+		}
+		if (a.MedianFirstResponse == 0) != (b.MedianFirstResponse == 0) {
+			return b.MedianFirstResponse == 0
+		}
+		if a.MedianFirstResponse != b.MedianFirstResponse {
+			return a.MedianFirstResponse < b.MedianFirstResponse
+		}
+		return a.Username < b.Username
+	})
+
+	return entries, nil
+}
+
+func (s *UserService) SetLeaderboardOptOut(ctx context.Context, userID string, optOut bool) error {
+	if err := s.repo.SetLeaderboardOptOut(ctx, userID, optOut); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
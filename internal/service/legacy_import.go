@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *AdminService) ImportLegacy(ctx context.Context, data domain.LegacyImport, dryRun bool) (domain.LegacyImportReport, error) {
+	report := domain.LegacyImportReport{DryRun: dryRun, Issues: []domain.LegacyImportIssue{}, Conflicts: []domain.LegacyImportIssue{}}
+	addIssue := func(item string, err error) {
+		report.Issues = append(report.Issues, domain.LegacyImportIssue{Item: item, Message: err.Error()})
+	}
+
+	now := s.now().UTC()
+	teams := make([]domain.Team, 0, len(data.Teams))
+	users := make(map[string]bool)
+	seenTeams := make(map[string]bool, len(data.Teams))
+	for _, t := range data.Teams {
+		item := "team " + t.Name
+		team, err := domain.NewTeam(t.Name, t.Members)
+		if err != nil {
+			addIssue(item, err)
+			continue
+		}
+		if err := s.validateTeamSize(team); err != nil {
+			addIssue(item, err)
+			continue
+		}
+		key := domain.TeamNameKey(team.Name)
+		if seenTeams[key] {
+			addIssue(item, errors.New("team is declared more than once"))
+			continue
+		}
+		seenTeams[key] = true
+
+		if _, err := s.repo.GetTeamByName(ctx, team.Name); err == nil {
+			addIssue(item, ErrTeamExists)
+			continue
+		} else if !errors.Is(err, repository.ErrTeamNotFound) {
+			return domain.LegacyImportReport{}, err
+		}
+
+		for _, m := range team.Members {
+			if users[m.UserID] {
+				addIssue(item, fmt.Errorf("user %s is declared in more than one team", m.UserID))
+				continue
+			}
+			users[m.UserID] = true
+		}
+		teams = append(teams, team)
+	}
+
+	existing, err := s.repo.ListExistingUserTeams(ctx, slices.Sorted(maps.Keys(users)))
+	if err != nil {
+		return domain.LegacyImportReport{}, err
+	}
+	for _, userID := range slices.Sorted(maps.Keys(existing)) {
+		message := "user already exists and is not in any team"
+		if teamName := existing[userID]; teamName != "" {
+			message = fmt.Sprintf("user already exists in team %s; import does not move users between teams", teamName)
+		}
+		report.Conflicts = append(report.Conflicts, domain.LegacyImportIssue{Item: "user " + userID, Message: message})
+	}
+
+	prs := make([]domain.PullRequest, 0, len(data.PullRequests))
+	seenPRs := make(map[string]bool, len(data.PullRequests))
+	for _, p := range data.PullRequests {
+		item := "pull request " + p.ID
+		pr, err := s.validateLegacyPullRequest(p, users, now)
+		if err != nil {
+			addIssue(item, err)
+			continue
+		}
+		if seenPRs[pr.ID] {
+			addIssue(item, errors.New("pull request is declared more than once"))
+			continue
+		}
+		seenPRs[pr.ID] = true
+
+		if _, err := s.repo.GetPullRequestIncludingDeleted(ctx, pr.ID); err == nil {
+			addIssue(item, ErrPullRequestExists)
+			continue
+		} else if !errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.LegacyImportReport{}, err
+		}
+		prs = append(prs, pr)
+	}
+
+	report.Teams = len(teams)
+	report.Users = len(users)
+	report.PullRequests = len(prs)
+	for _, pr := range prs {
+		report.Reviewers += len(pr.Assignments)
+	}
+	if dryRun || len(report.Issues) > 0 || len(report.Conflicts) > 0 {
+		return report, nil
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		for _, team := range teams {
+			created, err := s.repo.InsertTeam(ctx, tx, team.Name)
+			if err != nil {
+				if errors.Is(err, repository.ErrTeamExists) {
+					return fmt.Errorf("team %s: %w", team.Name, ErrTeamExists)
+				}
+				return err
+			}
+			for _, m := range team.Members {
+				if err := s.repo.ImportUser(ctx, tx, domain.User{ID: m.UserID, Username: m.Username, IsActive: m.IsActive, Seniority: m.Seniority}); err != nil {
+					if errors.Is(err, repository.ErrUserExists) {
+						return fmt.Errorf("user %s: %w", m.UserID, ErrUserExists)
+					}
+					return err
+				}
+				if err := s.repo.UpsertMembership(ctx, tx, created.ID, m.UserID); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, pr := range prs {
+			if err := s.repo.ImportPullRequest(ctx, tx, pr); err != nil {
+				switch {
+				case errors.Is(err, repository.ErrPullRequestExists):
+					return fmt.Errorf("pull request %s: %w", pr.ID, ErrPullRequestExists)
+				case errors.Is(err, repository.ErrDuplicatePullRequest):
+					return fmt.Errorf("pull request %s: %w", pr.ID, ErrDuplicatePullRequest)
+				}
+				return err
+			}
+			for _, a := range pr.Assignments {
+				if err := s.repo.ImportReviewer(ctx, tx, pr.ID, a.ReviewerID, a.AssignedAt); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.LegacyImportReport{}, err
+	}
+	s.members.invalidate()
+
+	report.Applied = true
+	s.logger.Info("legacy data imported",
+		zap.Int("teams", report.Teams),
+		zap.Int("users", report.Users),
+		zap.Int("pull_requests", report.PullRequests),
+		zap.Int("reviewers", report.Reviewers),
+	)
+	return report, nil
+}
+
+func (s *AdminService) validateLegacyPullRequest(p domain.PullRequest, users map[string]bool, now time.Time) (domain.PullRequest, error) {
+	pr, err := domain.NewPullRequest(p.ID, p.Name, p.AuthorID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if pr.ID == "" {
+		return domain.PullRequest{}, &domain.ValidationError{Field: "pull_request_id", Message: "is required"}
+	}
+	if !users[pr.AuthorID] {
+		return domain.PullRequest{}, fmt.Errorf("author %s is not declared in any imported team", pr.AuthorID)
+	}
+
+	pr.Status = p.Status
+	if pr.Status == "" {
+		pr.Status = domain.PullRequestStatusOpen
+	}
+	pr.CreatedAt = p.CreatedAt.UTC()
+	if pr.CreatedAt.IsZero() || pr.CreatedAt.After(now) {
+		return domain.PullRequest{}, &domain.ValidationError{Field: "created_at", Message: "is required and must not be in the future"}
+	}
+	if p.MergedAt != nil {
+		mergedAt := p.MergedAt.UTC()
+		if mergedAt.Before(pr.CreatedAt) || mergedAt.After(now) {
+			return domain.PullRequest{}, ErrInvalidMergeTime
+		}
+		pr.MergedAt = &mergedAt
+	}
+	if err := pr.ValidateStatus(); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	seen := make(map[string]bool, len(p.Assignments))
+	for _, a := range p.Assignments {
+		reviewerID := domain.NormalizeID(a.ReviewerID)
+		switch {
+		case !users[reviewerID]:
+			return domain.PullRequest{}, fmt.Errorf("reviewer %s is not declared in any imported team", reviewerID)
+		case reviewerID == pr.AuthorID:
+			return domain.PullRequest{}, fmt.Errorf("author %s cannot be a reviewer", reviewerID)
+		case seen[reviewerID]:
+			return domain.PullRequest{}, fmt.Errorf("reviewer %s is assigned more than once", reviewerID)
+		}
+		seen[reviewerID] = true
+
+		assignedAt := a.AssignedAt.UTC()
+		if assignedAt.IsZero() {
+			assignedAt = pr.CreatedAt
+		}
+		if assignedAt.Before(pr.CreatedAt) || assignedAt.After(now) {
+			return domain.PullRequest{}, fmt.Errorf("reviewer %s assigned_at must be between created_at and now", reviewerID)
+		}
+		pr.Reviewers = append(pr.Reviewers, reviewerID)
+		pr.Assignments = append(pr.Assignments, domain.ReviewerAssignment{ReviewerID: reviewerID, Kind: domain.AssignmentKindRegular, AssignedAt: assignedAt})
+	}
+
+	return pr, nil
+}
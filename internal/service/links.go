@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *PullRequestService) LinkPullRequests(ctx context.Context, prID, blockedByID string, linked bool) (domain.PullRequest, error) {
+	if prID == blockedByID {
+		return domain.PullRequest{}, ErrDependencyCycle
+	}
+
+	pr, err := s.repo.GetPullRequest(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if pr.Status == domain.PullRequestStatusMerged {
+		return domain.PullRequest{}, ErrPullRequestMerged
+	}
+
+	if _, err := s.repo.GetPullRequest(ctx, blockedByID); err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+
+	var updated domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if !linked {
+			if err := s.repo.UnlinkPullRequests(ctx, tx, prID, blockedByID); err != nil {
+				return err
+			}
+		} else if err := s.repo.LinkPullRequests(ctx, tx, prID, blockedByID); err != nil {
+			if errors.Is(err, repository.ErrDependencyCycle) {
+				return ErrDependencyCycle
+			}
+			return err
+		}
+		updated, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	s.pullRequests.invalidate(prID)
+
+	return updated, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"go.uber.org/zap"
+)
+
+const (
+	analyzeMinModifiedRows = 500
+	analyzeModifiedShare   = 10
+)
+
+var hotTables = map[string]bool{
+	"pull_requests":    true,
+	"pr_reviewers":     true,
+	"users":            true,
+	"team_memberships": true,
+}
+
+func (s *AdminService) GetDBStats(ctx context.Context) (domain.DBStats, error) {
+	return s.repo.GetDBStats(ctx)
+}
+
+func (s *AdminService) RunMaintenance(ctx context.Context) error {
+	stats, err := s.repo.GetDBStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range stats.Tables {
+		if !hotTables[table.Name] || !needsAnalyze(table) {
+			continue
+		}
+		if err := s.repo.AnalyzeTable(ctx, table.Name); err != nil {
+			return err
+		}
+		s.logger.Info("table analyzed",
+			zap.String("table", table.Name),
+			zap.Int64("modified_rows", table.ModifiedSinceAnalyze),
+		)
+	}
+
+	for _, table := range stats.Tables {
+		s.logger.Info("table size",
+			zap.String("table", table.Name),
+			zap.Int64("live_rows", table.LiveRows),
+			zap.Int64("dead_rows", table.DeadRows),
+			zap.Int64("total_bytes", table.TotalBytes),
+		)
+	}
+	for _, idx := range stats.Indexes {
+		s.logger.Info("index size",
+			zap.String("index", idx.Name),
+			zap.String("table", idx.Table),
+			zap.Int64("bytes", idx.Bytes),
+			zap.Int64("estimated_bloat_bytes", idx.EstimatedBloatBytes),
+		)
+	}
+
+	return nil
+}
+
+func needsAnalyze(table domain.TableStats) bool {
+	return table.ModifiedSinceAnalyze >= max(analyzeMinModifiedRows, table.LiveRows/analyzeModifiedShare)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+type teamSnapshot struct {
+	members  []domain.TeamMember
+	loadedAt time.Time
+}
+
+type memberSnapshotCache struct {
+	mu         sync.Mutex
+	teams      map[int64]teamSnapshot
+	generation uint64
+	hits       atomic.Int64
+	misses     atomic.Int64
+}
+
+func (c *memberSnapshotCache) invalidate() {
+	c.mu.Lock()
+	c.teams = nil
+	c.generation++
+	c.mu.Unlock()
+}
+
+func (s *base) activeTeamMembers(ctx context.Context, teamID int64) ([]domain.TeamMember, error) {
+	ttl := s.cfg.MemberSnapshotTTL
+	if ttl <= 0 {
+		return s.repo.ListActiveTeamMembers(ctx, teamID)
+	}
+
+	c := &s.members
+	now := s.now()
+	c.mu.Lock()
+	snapshot, ok := c.teams[teamID]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && now.Sub(snapshot.loadedAt) < ttl {
+		c.hits.Add(1)
+		return snapshot.members, nil
+	}
+
+	c.misses.Add(1)
+	members, err := s.repo.ListActiveTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		if c.teams == nil {
+			c.teams = make(map[int64]teamSnapshot)
+		}
+		c.teams[teamID] = teamSnapshot{members: members, loadedAt: now}
+	}
+	c.mu.Unlock()
+	return members, nil
+}
+
+func (s *base) pickActiveMembers(ctx context.Context, teamID int64, seniority domain.Seniority, exclude []string, limit int, prefer func(domain.TeamMember) bool) ([]domain.TeamMember, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	members, err := s.activeTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy, err := s.assignmentStrategy(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	picked, err := pickCandidates(members, seniority, exclude, limit, s.now(), prefer, func(candidates []domain.TeamMember) ([]domain.TeamMember, error) {
+		return strategy.Order(ctx, teamID, candidates)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := strategy.Assigned(ctx, teamID, picked); err != nil {
+		return nil, err
+	}
+	return picked, nil
+}
+
+func pickCandidates(members []domain.TeamMember, seniority domain.Seniority, exclude []string, limit int, now time.Time, prefer func(domain.TeamMember) bool, order func([]domain.TeamMember) ([]domain.TeamMember, error)) ([]domain.TeamMember, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	candidates := make([]domain.TeamMember, 0, len(members))
+	for _, m := range members {
+		if !m.IsActive || slices.Contains(exclude, m.UserID) || (seniority != "" && m.Seniority != seniority) {
+			continue
+		}
+		if slices.ContainsFunc(candidates, func(c domain.TeamMember) bool { return c.UserID == m.UserID }) {
+			continue
+		}
+		candidates = append(candidates, m)
+	}
+
+	candidates, err := order(candidates)
+	if err != nil {
+		return nil, err
+	}
+	rampingUp := func(m domain.TeamMember) bool {
+		return m.RampUpUntil != nil && m.RampUpUntil.After(now)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if prefer != nil {
+			if a, b := prefer(candidates[i]), prefer(candidates[j]); a != b {
+				return a
+			}
+		}
+		return !rampingUp(candidates[i]) && rampingUp(candidates[j])
+	})
+
+	return candidates[:min(limit, len(candidates))], nil
+}
+
+func (s *AdminService) MemberSnapshotStats() (int64, int64) {
+	return s.members.hits.Load(), s.members.misses.Load()
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+var seniorities = []domain.Seniority{domain.SeniorityJunior, domain.SeniorityMiddle, domain.SenioritySenior}
+
+type assignmentScenario struct {
+	Members  []domain.TeamMember
+	AuthorID string
+	Exclude  []string
+	Rules    []domain.QuorumRule
+	Base     int
+	Now      time.Time
+}
+
+func (assignmentScenario) Generate(r *rand.Rand, _ int) reflect.Value {
+	now := time.Date(2025, time.October, 1, 10, 0, 0, 0, time.UTC)
+	sc := assignmentScenario{Base: r.Intn(5), Now: now}
+
+	for i := range r.Intn(12) {
+		id := fmt.Sprintf("u%d", i)
+		if i > 0 && r.Intn(8) == 0 {
+			id = sc.Members[r.Intn(len(sc.Members))].UserID
+		}
+		m := domain.TeamMember{
+			UserID:    id,
+			Username:  id,
+			IsActive:  r.Intn(3) > 0,
+			Seniority: seniorities[r.Intn(len(seniorities))],
+		}
+		if r.Intn(4) == 0 {
+			until := now.Add(time.Duration(r.Intn(48)-24) * time.Hour)
+			m.RampUpUntil = &until
+		}
+		sc.Members = append(sc.Members, m)
+	}
+
+	sc.AuthorID = "outsider"
+	if len(sc.Members) > 0 && r.Intn(4) > 0 {
+		sc.AuthorID = sc.Members[r.Intn(len(sc.Members))].UserID
+	}
+	sc.Exclude = []string{sc.AuthorID}
+	for _, m := range sc.Members {
+		if r.Intn(6) == 0 {
+			sc.Exclude = append(sc.Exclude, m.UserID)
+		}
+	}
+
+	for _, seniority := range seniorities {
+		if r.Intn(3) == 0 {
+			sc.Rules = append(sc.Rules, domain.QuorumRule{Seniority: seniority, MinReviewers: 1 + r.Intn(3)})
+		}
+	}
+	return reflect.ValueOf(sc)
+}
+
+func (sc assignmentScenario) pick(seniority domain.Seniority, taken []string, limit int) []domain.TeamMember {
+	picked, err := pickCandidates(sc.Members, seniority, taken, limit, sc.Now, nil, func(candidates []domain.TeamMember) ([]domain.TeamMember, error) {
+		return randomStrategy{}.Order(context.Background(), 0, candidates)
+	})
+	if err != nil {
+		panic(err)
+	}
+	return picked
+}
+
+func (sc assignmentScenario) active(userID string) bool {
+	return slices.ContainsFunc(sc.Members, func(m domain.TeamMember) bool {
+		return m.UserID == userID && m.IsActive
+	})
+}
+
+func checkSelection(sc assignmentScenario, selected []string, limit int) error {
+	if len(selected) > limit {
+		return fmt.Errorf("selected %d reviewers, policy allows %d", len(selected), limit)
+	}
+	seen := make(map[string]bool, len(selected))
+	for _, id := range selected {
+		switch {
+		case id == sc.AuthorID:
+			return fmt.Errorf("author %s was assigned", id)
+		case seen[id]:
+			return fmt.Errorf("reviewer %s was assigned twice", id)
+		case !sc.active(id):
+			return fmt.Errorf("inactive reviewer %s was assigned", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func TestPickCandidatesProperties(t *testing.T) {
+	property := func(sc assignmentScenario, limit uint8) bool {
+		picked := sc.pick("", sc.Exclude, int(limit%8))
+		ids := make([]string, 0, len(picked))
+		for _, m := range picked {
+			if slices.Contains(sc.Exclude, m.UserID) {
+				t.Logf("excluded member %s was picked", m.UserID)
+				return false
+			}
+			ids = append(ids, m.UserID)
+		}
+		if err := checkSelection(sc, ids, int(limit%8)); err != nil {
+			t.Log(err)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSelectionAcrossRulesProperties(t *testing.T) {
+	property := func(sc assignmentScenario) bool {
+		total := reviewerCount(sc.Rules, sc.Base)
+		taken := slices.Clone(sc.Exclude)
+		var selected []string
+
+		for _, rule := range sc.Rules {
+			picked := sc.pick(rule.Seniority, taken, rule.MinReviewers)
+			for _, m := range picked {
+				if m.Seniority != rule.Seniority {
+					t.Logf("rule %s picked %s member %s", rule.Seniority, m.Seniority, m.UserID)
+					return false
+				}
+				taken = append(taken, m.UserID)
+				selected = append(selected, m.UserID)
+			}
+			if len(picked) < rule.MinReviewers {
+				break
+			}
+		}
+		if len(selected) < total {
+			for _, m := range sc.pick("", taken, total-len(selected)) {
+				selected = append(selected, m.UserID)
+			}
+		}
+
+		if err := checkSelection(sc, selected, total); err != nil {
+			t.Log(err)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPickCandidatesPrefersRampedUpMembers(t *testing.T) {
+	now := time.Date(2025, time.October, 1, 10, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	members := []domain.TeamMember{
+		{UserID: "ramping", IsActive: true, RampUpUntil: &later},
+		{UserID: "ready", IsActive: true},
+	}
+	keep := func(candidates []domain.TeamMember) ([]domain.TeamMember, error) { return candidates, nil }
+
+	picked, err := pickCandidates(members, "", nil, 1, now, nil, keep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(picked) != 1 || picked[0].UserID != "ready" {
+		t.Errorf("picked = %+v, want the ramped-up member first", picked)
+	}
+}
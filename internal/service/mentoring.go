@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *TeamService) SetMentoringShadows(ctx context.Context, teamName string, enabled bool) (domain.Team, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.SetMentoringShadows(ctx, tx, team.ID, enabled)
+	})
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	return s.getTeam(ctx, teamName)
+}
+
+func (s *PullRequestService) selectShadowReviewer(ctx context.Context, teamID int64, exclude, reviewers []string) (string, error) {
+	enabled, err := s.repo.IsMentoringEnabled(ctx, teamID)
+	if err != nil || !enabled {
+		return "", err
+	}
+
+	seniorities, err := s.repo.ListUserSeniorities(ctx, reviewers)
+	if err != nil {
+		return "", err
+	}
+	hasSenior := false
+	for _, reviewer := range reviewers {
+		if seniorities[reviewer] == domain.SenioritySenior {
+			hasSenior = true
+			break
+		}
+	}
+	if !hasSenior {
+		return "", nil
+	}
+
+	taken := append(append([]string{}, exclude...), reviewers...)
+	mentees, err := s.repo.ListRandomMentees(ctx, teamID, taken, 1)
+	if err != nil || len(mentees) == 0 {
+		return "", err
+	}
+
+	return mentees[0].UserID, nil
+}
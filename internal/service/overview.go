@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (s *AdminService) GetOverview(ctx context.Context) (domain.AdminOverview, error) {
+	return s.repo.GetAdminOverview(ctx)
+}
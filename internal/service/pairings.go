@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (s *StatsService) GetPairingGraph(ctx context.Context, teamName string, period time.Duration) (domain.PairingGraph, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.PairingGraph{}, err
+	}
+
+	var since *time.Time
+	if period > 0 {
+		t := s.now().UTC().Add(-period)
+		since = &t
+	}
+
+	edges, err := s.repo.ListPairings(ctx, team.ID, since)
+	if err != nil {
+		return domain.PairingGraph{}, err
+	}
+
+	graph := domain.PairingGraph{TeamName: team.Name, Edges: edges}
+	seen := make(map[string]bool, len(team.Members))
+	for _, m := range team.Members {
+		seen[m.UserID] = true
+		graph.Nodes = append(graph.Nodes, domain.PairingNode{UserID: m.UserID, Username: m.Username, IsMember: true})
+	}
+	for _, e := range edges {
+		if !seen[e.ReviewerID] {
+			seen[e.ReviewerID] = true
+			graph.Nodes = append(graph.Nodes, domain.PairingNode{UserID: e.ReviewerID})
+		}
+	}
+	return graph, nil
+}
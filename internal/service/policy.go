@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *AdminService) GetEffectivePolicy(ctx context.Context, teamName, prID string) (domain.PolicyResolution, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.PolicyResolution{}, err
+	}
+
+	prID = domain.NormalizeID(prID)
+	if prID != "" {
+		if _, err := s.repo.GetPullRequest(ctx, prID); err != nil {
+			if errors.Is(err, repository.ErrPullRequestNotFound) {
+				return domain.PolicyResolution{}, ErrPullRequestNotFound
+			}
+			return domain.PolicyResolution{}, err
+		}
+	}
+
+	resolution, err := s.resolvePolicies(ctx, team.ID, prID, team.Quorum)
+	if err != nil {
+		return domain.PolicyResolution{}, err
+	}
+	resolution.TeamName = team.Name
+	return resolution, nil
+}
+
+func (s *AdminService) SetPolicyOverride(ctx context.Context, scope domain.PolicySource, teamName, prID string, key domain.PolicyKey, value string) (domain.PolicyOverride, error) {
+	override, err := domain.NewPolicyOverride(scope, teamName, prID, key, value)
+	if err != nil {
+		return domain.PolicyOverride{}, err
+	}
+
+	var teamID *int64
+	if override.Scope == domain.PolicySourceTeam {
+		team, err := s.getTeam(ctx, override.TeamName)
+		if err != nil {
+			return domain.PolicyOverride{}, err
+		}
+		teamID, override.TeamName = &team.ID, team.Name
+	}
+
+	override.UpdatedAt = s.now().UTC()
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if override.Value == "" {
+			return s.repo.DeletePolicyOverride(ctx, tx, override.Scope, teamID, override.PullRequestID, override.Key)
+		}
+		err := s.repo.UpsertPolicyOverride(ctx, tx, override, teamID, override.UpdatedAt)
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return ErrPullRequestNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return domain.PolicyOverride{}, err
+	}
+
+	return override, nil
+}
+
+// UpdateTeam sets the team's reviewers_per_pr override, or removes it when
+// reviewersRequired is nil, and returns the resulting reviewer count.
+func (s *TeamService) UpdateTeam(ctx context.Context, teamName string, reviewersRequired *int) (domain.Team, int, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, 0, err
+	}
+	override, err := teamReviewersOverride(team.Name, reviewersRequired)
+	if err != nil {
+		return domain.Team{}, 0, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if override.Value == "" {
+			return s.repo.DeletePolicyOverride(ctx, tx, override.Scope, &team.ID, "", override.Key)
+		}
+		return s.repo.UpsertPolicyOverride(ctx, tx, override, &team.ID, s.now().UTC())
+	})
+	if err != nil {
+		return domain.Team{}, 0, err
+	}
+
+	policies, err := s.resolvePolicies(ctx, team.ID, "", team.Quorum)
+	if err != nil {
+		return domain.Team{}, 0, err
+	}
+	return team, policies.Get(domain.PolicyReviewersPerPR).Int(), nil
+}
+
+func teamReviewersOverride(teamName string, reviewersRequired *int) (domain.PolicyOverride, error) {
+	value := ""
+	if reviewersRequired != nil {
+		value = strconv.Itoa(*reviewersRequired)
+	}
+	return domain.NewPolicyOverride(domain.PolicySourceTeam, teamName, "", domain.PolicyReviewersPerPR, value)
+}
+
+func (s *base) resolvePolicies(ctx context.Context, teamID int64, prID string, rules []domain.QuorumRule) (domain.PolicyResolution, error) {
+	overrides, err := s.repo.ListPolicyOverrides(ctx, teamID, prID)
+	if err != nil {
+		return domain.PolicyResolution{}, err
+	}
+
+	defaults := map[domain.PolicyKey]string{
+		domain.PolicyReviewersPerPR: strconv.Itoa(defaultReviewerCount),
+		domain.PolicyReviewSLA:      s.cfg.ReviewSLA.String(),
+		domain.PolicySnoozeBudget:   s.cfg.SnoozeBudget.String(),
+		domain.PolicyMinTZOverlap:   s.cfg.MinTZOverlap.String(),
+		domain.PolicyMinApprovals:   strconv.Itoa(s.cfg.MinApprovals),
+	}
+
+	resolution := domain.PolicyResolution{PullRequestID: prID}
+	for _, key := range domain.PolicyKeys {
+		policy := domain.EffectivePolicy{
+			Key:    key,
+			Value:  defaults[key],
+			Source: domain.PolicySourceConfig,
+			Layers: []domain.PolicyLayer{{Source: domain.PolicySourceConfig, Value: defaults[key]}},
+		}
+		for _, scope := range key.Scopes() {
+			for _, o := range overrides {
+				if o.Key == key && o.Scope == scope {
+					policy.Value, policy.Source = o.Value, scope
+					policy.Layers = append(policy.Layers, domain.PolicyLayer{Source: scope, Value: o.Value})
+				}
+			}
+		}
+		if key == domain.PolicyReviewersPerPR && len(rules) > 0 {
+			required := reviewerCount(rules, 0)
+			policy.Layers = append(policy.Layers, domain.PolicyLayer{Source: domain.PolicySourceQuorum, Value: strconv.Itoa(required)})
+			if required > policy.Int() {
+				policy.Value, policy.Source = strconv.Itoa(required), domain.PolicySourceQuorum
+			}
+		}
+		resolution.Policies = append(resolution.Policies, policy)
+	}
+
+	return resolution, nil
+}
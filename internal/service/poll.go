@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+const reviewPollInterval = time.Second
+
+func (s *UserService) WaitReviewChange(ctx context.Context, userID, since string) (string, []domain.PullRequestShort, *time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.LongPollMaxWait)
+	defer cancel()
+
+	focus, err := s.repo.GetFocusSchedule(ctx, userID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	ticker := time.NewTicker(reviewPollInterval)
+	defer ticker.Stop()
+
+	for {
+		prs, err := s.repo.ListPullRequestsForReviewer(ctx, userID, domain.Page{SortBy: "created_at", Desc: true}, false)
+		if err != nil {
+			if ctx.Err() != nil && since != "" {
+				return since, nil, nil, nil
+			}
+			return "", nil, nil, err
+		}
+
+		token := reviewQueueToken(prs)
+		heldUntil, held := focus.HeldUntil(s.now())
+		if token != since && (since == "" || !held) {
+			return token, prs, nil, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if token != since {
+				return since, nil, &heldUntil, nil
+			}
+			return token, prs, nil, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func reviewQueueToken(prs []domain.PullRequestShort) string {
+	h := sha256.New()
+	for _, pr := range prs {
+		h.Write([]byte(pr.ID))
+		h.Write([]byte{0})
+		h.Write([]byte(pr.Status))
+		h.Write([]byte{0})
+		if pr.ApprovalsResetAt != nil {
+			h.Write([]byte(pr.ApprovalsResetAt.Format(time.RFC3339Nano)))
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *PullRequestService) CreatePullRequest(ctx context.Context, prID, prName, authorID string, linesChanged *int) (domain.PullRequest, error) {
+	draft, err := domain.NewPullRequest(prID, prName, authorID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if linesChanged, err = domain.NewLinesChanged(linesChanged); err != nil {
+		return domain.PullRequest{}, err
+	}
+	prID, prName, authorID = draft.ID, draft.Name, draft.AuthorID
+	switch {
+	case prID == "":
+		prID = s.ids.NewID()
+	case s.cfg.RequireUUIDPullRequestID && !domain.IsUUID(prID):
+		return domain.PullRequest{}, ErrInvalidPullRequestID
+	}
+	if err := s.checkAuthorQuota(ctx, authorID); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	frozen, err := s.assignmentFrozen(ctx)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if frozen {
+		return s.createDeferredPullRequest(ctx, prID, prName, authorID, linesChanged)
+	}
+
+	author, exclude, err := s.authorForAssignment(ctx, authorID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.PullRequest{}, ErrUserNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if author.TeamID == nil {
+		return domain.PullRequest{}, ErrTeamNotFound
+	}
+
+	reviewDueAt, err := s.reviewDeadline(ctx, *author.TeamID, s.now().UTC())
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	var shadow *domain.AssignmentShadowSample
+	var created domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := s.repo.CreatePullRequest(ctx, tx, domain.PullRequest{
+			ID:           prID,
+			Name:         prName,
+			AuthorID:     authorID,
+			Status:       domain.PullRequestStatusOpen,
+			ReviewDueAt:  reviewDueAt,
+			LinesChanged: linesChanged,
+		})
+		if err != nil {
+			if errors.Is(err, repository.ErrPullRequestExists) {
+				return ErrPullRequestExists
+			}
+			if errors.Is(err, repository.ErrDuplicatePullRequest) {
+				return ErrDuplicatePullRequest
+			}
+			return err
+		}
+
+		reviewerIDs, err := s.selectReviewers(ctx, *author.TeamID, author.ID, exclude)
+		if err != nil {
+			return err
+		}
+
+		if s.cfg.ShadowAssignment {
+			shadow = s.shadowAssignment(ctx, prID, *author.TeamID, exclude, reviewerIDs)
+		}
+
+		if err := s.repo.AddReviewers(ctx, tx, prID, reviewerIDs); err != nil {
+			if errors.Is(err, repository.ErrReviewerAlreadyAssigned) {
+				return ErrReviewerAlreadyAssigned
+			}
+			return err
+		}
+
+		shadowID, err := s.selectShadowReviewer(ctx, *author.TeamID, exclude, reviewerIDs)
+		if err != nil {
+			return err
+		}
+		if shadowID != "" {
+			if err := s.repo.AddShadowReviewer(ctx, tx, prID, shadowID); err != nil {
+				if errors.Is(err, repository.ErrReviewerAlreadyAssigned) {
+					return ErrReviewerAlreadyAssigned
+				}
+				return err
+			}
+		}
+
+		created, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if errors.Is(err, ErrDuplicatePullRequest) {
+		return domain.PullRequest{}, s.duplicatePullRequestError(ctx, authorID, prName)
+	}
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	if shadow != nil && !ctxutil.DryRun(ctx) {
+		s.recordShadowAssignment(ctx, *author.TeamID, *shadow)
+	}
+
+	return created, nil
+}
+
+func (s *PullRequestService) ReassignReviewer(ctx context.Context, prID, oldReviewerID string, reason domain.DeclineReason, note string) (domain.PullRequest, string, error) {
+	prID, oldReviewerID = domain.NormalizeID(prID), domain.NormalizeID(oldReviewerID)
+	if ctxutil.DryRun(ctx) {
+		return s.reassignReviewer(ctx, prID, oldReviewerID, reason, note)
+	}
+	return s.reassigns.do(s.now, s.cfg.ReassignDedupeWindow, prID+"/"+oldReviewerID, func() (domain.PullRequest, string, error) {
+		return s.reassignReviewer(ctx, prID, oldReviewerID, reason, note)
+	})
+}
+
+func (s *PullRequestService) reassignReviewer(ctx context.Context, prID, oldReviewerID string, reason domain.DeclineReason, note string) (domain.PullRequest, string, error) {
+	note, err := domain.NewHandoffNote(note)
+	if err != nil {
+		return domain.PullRequest{}, "", err
+	}
+	reason, err = domain.NewDeclineReason(reason, note, s.cfg.RequireDeclineReason)
+	if err != nil {
+		return domain.PullRequest{}, "", err
+	}
+
+	var updated domain.PullRequest
+	var replacement string
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		pr, err := s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return ErrPullRequestNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if replacement, err = s.pickReplacement(ctx, pr, oldReviewerID); err != nil {
+			return err
+		}
+
+		if err := s.repo.ReplaceReviewer(ctx, tx, prID, oldReviewerID, replacement, note); err != nil {
+			if errors.Is(err, repository.ErrReviewerNotAssigned) {
+				return ErrReviewerNotAssigned
+			}
+			if errors.Is(err, repository.ErrReviewerAlreadyAssigned) {
+				return ErrReviewerAlreadyAssigned
+			}
+			return err
+		}
+		if err := s.repo.RecordReviewerDecline(ctx, tx, domain.ReviewerDecline{
+			PullRequestID: prID,
+			ReviewerID:    oldReviewerID,
+			Reason:        reason,
+			Note:          note,
+			ReplacedBy:    replacement,
+			DeclinedAt:    s.now().UTC(),
+		}); err != nil {
+			return err
+		}
+		updated, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, "", err
+	}
+	s.pullRequests.invalidate(prID)
+
+	return updated, replacement, nil
+}
+
+func (s *PullRequestService) pickReplacement(ctx context.Context, pr domain.PullRequest, oldReviewerID string, avoid ...string) (string, error) {
+	if pr.Status == domain.PullRequestStatusMerged {
+		return "", ErrPullRequestMerged
+	}
+	if !slices.Contains(pr.Reviewers, oldReviewerID) {
+		return "", ErrReviewerNotAssigned
+	}
+
+	reviewerUser, err := s.repo.GetUser(ctx, oldReviewerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return "", ErrUserNotFound
+		}
+		return "", err
+	}
+	if reviewerUser.TeamID == nil {
+		return "", ErrNoCandidate
+	}
+
+	conflicted, err := s.repo.ListConflictedReviewers(ctx, pr.ID)
+	if err != nil {
+		return "", err
+	}
+
+	exclude, err := s.assignmentExclusions(ctx, pr.AuthorID, pr.Reviewers, pr.Shadows, avoid, conflicted)
+	if err != nil {
+		return "", err
+	}
+
+	return s.selectReplacement(ctx, *reviewerUser.TeamID, pr.AuthorID, pr.Reviewers, oldReviewerID, exclude)
+}
+
+func (s *PullRequestService) MergePullRequest(ctx context.Context, prID string, actor domain.MergeActor) (domain.PullRequest, error) {
+	prID = domain.NormalizeID(prID)
+
+	mergedAt := s.now().UTC()
+	if actor.MergedAt != nil {
+		if actor.MergedAt.After(mergedAt) {
+			return domain.PullRequest{}, ErrInvalidMergeTime
+		}
+		mergedAt = actor.MergedAt.UTC()
+	}
+	var mergedBy *string
+	if actor.MergedBy != "" {
+		mergedBy = &actor.MergedBy
+	}
+
+	var merged domain.PullRequest
+	err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		pr, err := s.repo.LockPullRequest(ctx, tx, prID)
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return ErrPullRequestNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := pr.ValidateStatus(); err != nil {
+			return err
+		}
+		if pr.Status == domain.PullRequestStatusMerged {
+			merged = pr
+			return nil
+		}
+		if err := s.ensureChecklistComplete(ctx, pr); err != nil {
+			return err
+		}
+		if err := s.ensureQuorumMet(ctx, pr); err != nil {
+			return err
+		}
+		if err := s.ensureApprovalsMet(ctx, pr); err != nil {
+			return err
+		}
+		if mergedAt.Before(pr.CreatedAt) {
+			return ErrInvalidMergeTime
+		}
+
+		merged, err = s.repo.MarkPullRequestMerged(ctx, tx, prID, mergedAt, mergedBy)
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return ErrPullRequestNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	s.pullRequests.invalidate(prID)
+
+	return merged, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+)
+
+const maxCachedPullRequests = 10000
+
+type cachedPullRequest struct {
+	pr       domain.PullRequest
+	loadedAt time.Time
+}
+
+type pullRequestCache struct {
+	mu         sync.Mutex
+	items      map[string]cachedPullRequest
+	blocking   map[string]map[string]struct{}
+	generation uint64
+	hits       atomic.Int64
+	misses     atomic.Int64
+}
+
+func (c *pullRequestCache) invalidate(prIDs ...string) {
+	c.mu.Lock()
+	for _, id := range prIDs {
+		for blocked := range c.blocking[id] {
+			c.remove(blocked)
+		}
+		c.remove(id)
+	}
+	c.generation++
+	c.mu.Unlock()
+}
+
+func (c *pullRequestCache) invalidateAll() {
+	c.mu.Lock()
+	c.items = nil
+	c.blocking = nil
+	c.generation++
+	c.mu.Unlock()
+}
+
+func (c *pullRequestCache) remove(prID string) {
+	cached, ok := c.items[prID]
+	if !ok {
+		return
+	}
+	delete(c.items, prID)
+	for _, b := range cached.pr.BlockedBy {
+		delete(c.blocking[b.ID], prID)
+		if len(c.blocking[b.ID]) == 0 {
+			delete(c.blocking, b.ID)
+		}
+	}
+}
+
+func (c *pullRequestCache) lookup(prID string, now time.Time, ttl time.Duration) (domain.PullRequest, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.items[prID]
+	if !ok {
+		return domain.PullRequest{}, false
+	}
+	if now.Sub(cached.loadedAt) >= ttl {
+		c.remove(prID)
+		return domain.PullRequest{}, false
+	}
+	return cached.pr, true
+}
+
+func (c *pullRequestCache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+func (c *pullRequestCache) store(pr domain.PullRequest, generation uint64, now time.Time, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return
+	}
+	if c.items == nil {
+		c.items = make(map[string]cachedPullRequest)
+		c.blocking = make(map[string]map[string]struct{})
+	}
+	c.remove(pr.ID)
+	if len(c.items) >= maxCachedPullRequests {
+		for id, cached := range c.items {
+			if now.Sub(cached.loadedAt) >= ttl {
+				c.remove(id)
+			}
+		}
+	}
+	for id := range c.items {
+		if len(c.items) < maxCachedPullRequests {
+			break
+		}
+		c.remove(id)
+	}
+
+	c.items[pr.ID] = cachedPullRequest{pr: pr, loadedAt: now}
+	for _, b := range pr.BlockedBy {
+		if c.blocking[b.ID] == nil {
+			c.blocking[b.ID] = make(map[string]struct{})
+		}
+		c.blocking[b.ID][pr.ID] = struct{}{}
+	}
+}
+
+func (s *PullRequestService) GetPullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	prID = domain.NormalizeID(prID)
+	ttl := s.cfg.PullRequestCacheTTL
+	if ttl <= 0 {
+		return s.loadPullRequest(ctx, prID)
+	}
+
+	c := &s.pullRequests
+	now := s.now()
+	if pr, ok := c.lookup(prID, now, ttl); ok {
+		c.hits.Add(1)
+		return pr, nil
+	}
+
+	generation := c.currentGeneration()
+	c.misses.Add(1)
+	pr, err := s.loadPullRequest(ctx, prID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	if !ctxutil.ReplicaReads(ctx) {
+		c.store(pr, generation, now, ttl)
+	}
+	return pr, nil
+}
+
+func (s *PullRequestService) loadPullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	pr, err := s.repo.GetPullRequest(ctx, prID)
+	if errors.Is(err, repository.ErrPullRequestNotFound) {
+		return domain.PullRequest{}, ErrPullRequestNotFound
+	}
+	return pr, err
+}
+
+func (s *AdminService) PullRequestCacheStats() (int64, int64) {
+	return s.pullRequests.hits.Load(), s.pullRequests.misses.Load()
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func cachedPR(id string, blockedBy ...string) domain.PullRequest {
+	pr := domain.PullRequest{ID: id, Status: domain.PullRequestStatusOpen}
+	for _, b := range blockedBy {
+		pr.BlockedBy = append(pr.BlockedBy, domain.PullRequestShort{ID: b})
+	}
+	return pr
+}
+
+func TestPullRequestCacheInvalidation(t *testing.T) {
+	now := time.Date(2025, time.October, 1, 10, 0, 0, 0, time.UTC)
+	ttl := time.Minute
+
+	t.Run("invalidates the pull request and the ones it blocks", func(t *testing.T) {
+		var c pullRequestCache
+		c.store(cachedPR("pr-1"), c.currentGeneration(), now, ttl)
+		c.store(cachedPR("pr-2", "pr-1"), c.currentGeneration(), now, ttl)
+		c.store(cachedPR("pr-3", "pr-4"), c.currentGeneration(), now, ttl)
+
+		c.invalidate("pr-1")
+		for _, id := range []string{"pr-1", "pr-2"} {
+			if _, ok := c.lookup(id, now, ttl); ok {
+				t.Errorf("%s is still cached after invalidating pr-1", id)
+			}
+		}
+		if _, ok := c.lookup("pr-3", now, ttl); !ok {
+			t.Errorf("pr-3 was dropped, it does not depend on pr-1")
+		}
+		if len(c.blocking["pr-1"]) != 0 {
+			t.Errorf("blocking index still lists %v for pr-1", c.blocking["pr-1"])
+		}
+	})
+
+	t.Run("replaced entry drops stale blockers", func(t *testing.T) {
+		var c pullRequestCache
+		c.store(cachedPR("pr-2", "pr-1"), c.currentGeneration(), now, ttl)
+		c.store(cachedPR("pr-2"), c.currentGeneration(), now, ttl)
+
+		if _, ok := c.blocking["pr-1"]; ok {
+			t.Errorf("blocking index kept pr-1 after pr-2 was unlinked")
+		}
+	})
+
+	t.Run("load started before an invalidation is not stored", func(t *testing.T) {
+		var c pullRequestCache
+		generation := c.currentGeneration()
+		c.invalidate("pr-1")
+		c.store(cachedPR("pr-1"), generation, now, ttl)
+
+		if _, ok := c.lookup("pr-1", now, ttl); ok {
+			t.Errorf("stale load was cached")
+		}
+	})
+
+	t.Run("expired entries are evicted", func(t *testing.T) {
+		var c pullRequestCache
+		c.store(cachedPR("pr-1", "pr-0"), c.currentGeneration(), now, ttl)
+
+		if _, ok := c.lookup("pr-1", now.Add(ttl), ttl); ok {
+			t.Fatalf("expired entry was served")
+		}
+		if len(c.items) != 0 || len(c.blocking) != 0 {
+			t.Errorf("expired entry kept: items=%d blocking=%d", len(c.items), len(c.blocking))
+		}
+	})
+
+	t.Run("size is bounded", func(t *testing.T) {
+		var c pullRequestCache
+		for i := range maxCachedPullRequests + 10 {
+			c.store(cachedPR(fmt.Sprintf("pr-%d", i)), c.currentGeneration(), now, ttl)
+		}
+		if len(c.items) > maxCachedPullRequests {
+			t.Errorf("cache holds %d entries, limit is %d", len(c.items), maxCachedPullRequests)
+		}
+	})
+}
+
+func TestPullRequestCacheConcurrentInvalidation(t *testing.T) {
+	now := time.Date(2025, time.October, 1, 10, 0, 0, 0, time.UTC)
+	ttl := time.Minute
+	var c pullRequestCache
+
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 500 {
+				id := fmt.Sprintf("pr-%d", (w+i)%16)
+				generation := c.currentGeneration()
+				c.store(cachedPR(id, fmt.Sprintf("pr-%d", (w+i+1)%16)), generation, now, ttl)
+				if i%3 == 0 {
+					c.invalidate(id)
+				}
+				c.lookup(id, now, ttl)
+			}
+		}()
+	}
+	wg.Wait()
+
+	c.invalidate("pr-0")
+	if _, ok := c.lookup("pr-0", now, ttl); ok {
+		t.Errorf("pr-0 is cached after invalidation")
+	}
+	for blocker, blocked := range c.blocking {
+		for id := range blocked {
+			cached, ok := c.items[id]
+			if !ok {
+				t.Errorf("blocking index lists %s under %s, but it is not cached", id, blocker)
+				continue
+			}
+			if len(cached.pr.BlockedBy) == 0 || cached.pr.BlockedBy[0].ID != blocker {
+				t.Errorf("blocking index lists %s under %s, but it is blocked by %v", id, blocker, cached.pr.BlockedBy)
+			}
+		}
+	}
+	for id, cached := range c.items {
+		for _, b := range cached.pr.BlockedBy {
+			if _, ok := c.blocking[b.ID][id]; !ok {
+				t.Errorf("%s is blocked by %s but missing from the index", id, b.ID)
+			}
+		}
+	}
+}
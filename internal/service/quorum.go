@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const defaultReviewerCount = 2
+
+func (s *TeamService) SetTeamQuorum(ctx context.Context, teamName string, rules []domain.QuorumRule) (domain.Team, error) {
+	seen := make(map[domain.Seniority]bool, len(rules))
+	for _, rule := range rules {
+		if !rule.Seniority.Valid() {
+			return domain.Team{}, fmt.Errorf("%w: unknown seniority %q", ErrInvalidQuorum, rule.Seniority)
+		}
+		if rule.MinReviewers <= 0 {
+			return domain.Team{}, fmt.Errorf("%w: min_reviewers must be positive", ErrInvalidQuorum)
+		}
+		if seen[rule.Seniority] {
+			return domain.Team{}, fmt.Errorf("%w: seniority %q is declared more than once", ErrInvalidQuorum, rule.Seniority)
+		}
+		seen[rule.Seniority] = true
+	}
+
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.ReplaceQuorumRules(ctx, tx, team.ID, rules)
+	})
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	return s.getTeam(ctx, teamName)
+}
+
+func (s *PullRequestService) selectReviewers(ctx context.Context, teamID int64, authorID string, exclude []string) ([]string, error) {
+	rules, err := s.repo.ListQuorumRules(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := s.resolvePolicies(ctx, teamID, "", rules)
+	if err != nil {
+		return nil, err
+	}
+	total := policies.Get(domain.PolicyReviewersPerPR).Int()
+
+	prefer, err := s.overlapPreference(ctx, authorID, policies)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := append([]string{}, exclude...)
+	selected := make([]string, 0, total)
+
+	onDuty, hasOnDuty, err := s.onDutyReviewer(ctx, teamID, exclude)
+	if err != nil {
+		return nil, err
+	}
+	if hasOnDuty && total > 0 {
+		taken = append(taken, onDuty.UserID)
+		selected = append(selected, onDuty.UserID)
+	} else {
+		hasOnDuty = false
+	}
+
+	for _, rule := range rules {
+		need := rule.MinReviewers
+		if hasOnDuty && onDuty.Seniority == rule.Seniority && need > 0 {
+			need--
+			hasOnDuty = false
+		}
+		members, err := s.pickActiveMembers(ctx, teamID, rule.Seniority, taken, need, prefer)
+		if err != nil {
+			return nil, err
+		}
+		if len(members) < need {
+			return nil, fmt.Errorf("%w: requires %d %s reviewer(s), %d available",
+				ErrQuorumUnsatisfied, rule.MinReviewers, rule.Seniority, len(members)+rule.MinReviewers-need)
+		}
+		for _, member := range members {
+			taken = append(taken, member.UserID)
+			selected = append(selected, member.UserID)
+		}
+	}
+
+	if len(selected) < total {
+		members, err := s.pickActiveMembers(ctx, teamID, "", taken, total-len(selected), prefer)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			selected = append(selected, member.UserID)
+		}
+	}
+
+	return selected, nil
+}
+
+func (s *PullRequestService) selectReplacement(ctx context.Context, teamID int64, authorID string, reviewers []string, oldReviewerID string, exclude []string) (string, error) {
+	rules, err := s.repo.ListQuorumRules(ctx, teamID)
+	if err != nil {
+		return "", err
+	}
+
+	policies, err := s.resolvePolicies(ctx, teamID, "", rules)
+	if err != nil {
+		return "", err
+	}
+	prefer, err := s.overlapPreference(ctx, authorID, policies)
+	if err != nil {
+		return "", err
+	}
+
+	remaining := make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		if reviewer != oldReviewerID {
+			remaining = append(remaining, reviewer)
+		}
+	}
+
+	var required domain.Seniority
+	failed, err := s.firstUnmetRule(ctx, rules, remaining)
+	if err != nil {
+		return "", err
+	}
+	if failed != nil {
+		required = failed.rule.Seniority
+	}
+
+	candidates, err := s.pickActiveMembers(ctx, teamID, required, exclude, 1, prefer)
+	if err != nil {
+		return "", err
+	}
+	if len(candidates) == 0 {
+		if failed != nil {
+			return "", fmt.Errorf("%w: requires %d %s reviewer(s), no active replacement available",
+				ErrQuorumUnsatisfied, failed.rule.MinReviewers, failed.rule.Seniority)
+		}
+		return "", ErrNoCandidate
+	}
+
+	return candidates[0].UserID, nil
+}
+
+func (s *PullRequestService) ensureQuorumMet(ctx context.Context, pr domain.PullRequest) error {
+	author, err := s.repo.GetUser(ctx, pr.AuthorID)
+	if err != nil {
+		return err
+	}
+	if author.TeamID == nil {
+		return nil
+	}
+
+	rules, err := s.repo.ListQuorumRules(ctx, *author.TeamID)
+	if err != nil {
+		return err
+	}
+
+	failed, err := s.firstUnmetRule(ctx, rules, pr.Reviewers)
+	if err != nil {
+		return err
+	}
+	if failed != nil {
+		return fmt.Errorf("%w: requires %d %s reviewer(s), %d assigned",
+			ErrQuorumNotMet, failed.rule.MinReviewers, failed.rule.Seniority, failed.assigned)
+	}
+
+	return nil
+}
+
+func reviewerCount(rules []domain.QuorumRule, base int) int {
+	total := 0
+	for _, rule := range rules {
+		total += rule.MinReviewers
+	}
+	return max(total, base)
+}
+
+type unmetRule struct {
+	rule     domain.QuorumRule
+	assigned int
+}
+
+func (s *PullRequestService) firstUnmetRule(ctx context.Context, rules []domain.QuorumRule, reviewers []string) (*unmetRule, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	seniorities, err := s.repo.ListUserSeniorities(ctx, reviewers)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[domain.Seniority]int, len(rules))
+	for _, reviewer := range reviewers {
+		counts[seniorities[reviewer]]++
+	}
+
+	for _, rule := range rules {
+		if counts[rule.Seniority] < rule.MinReviewers {
+			return &unmetRule{rule: rule, assigned: counts[rule.Seniority]}, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *TeamService) SetTeamRampUp(ctx context.Context, teamName string, days int) (domain.Team, error) {
+	if err := domain.ValidateRampUpDays(days); err != nil {
+		return domain.Team{}, err
+	}
+
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.SetTeamRampUpDays(ctx, tx, team.ID, days)
+	})
+	if err != nil {
+		return domain.Team{}, err
+	}
+	s.members.invalidate()
+
+	return s.getTeam(ctx, teamName)
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *PullRequestService) ReassignAll(ctx context.Context, userID string, reason domain.DeclineReason, note string) (domain.BulkReassignReport, error) {
+	userID = domain.NormalizeID(userID)
+	note, err := domain.NewHandoffNote(note)
+	if err != nil {
+		return domain.BulkReassignReport{}, err
+	}
+	reason, err = domain.NewDeclineReason(reason, note, s.cfg.RequireDeclineReason)
+	if err != nil {
+		return domain.BulkReassignReport{}, err
+	}
+
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.BulkReassignReport{}, ErrUserNotFound
+		}
+		return domain.BulkReassignReport{}, err
+	}
+
+	assignments, err := s.repo.ListOpenAssignments(ctx, userID)
+	if err != nil {
+		return domain.BulkReassignReport{}, err
+	}
+
+	report := domain.BulkReassignReport{UserID: userID, Results: make([]domain.BulkReassignResult, 0, len(assignments))}
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var picked []string
+		for _, a := range assignments {
+			result, err := s.reassignOne(ctx, tx, a, userID, reason, note, picked)
+			if err != nil {
+				return err
+			}
+			if result.Status == domain.BulkReassignReassigned {
+				picked = append(picked, result.ReplacedBy)
+			}
+			report.Results = append(report.Results, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.BulkReassignReport{}, err
+	}
+
+	counts := make(map[domain.BulkReassignStatus]int, 3)
+	for _, result := range report.Results {
+		counts[result.Status]++
+		if result.Status == domain.BulkReassignReassigned {
+			s.pullRequests.invalidate(result.PullRequestID)
+		}
+	}
+	s.logger.Info("bulk reassignment",
+		zap.String("user_id", userID),
+		zap.Int("reassigned", counts[domain.BulkReassignReassigned]),
+		zap.Int("no_candidate", counts[domain.BulkReassignNoCandidate]),
+		zap.Int("skipped", counts[domain.BulkReassignSkipped]),
+	)
+
+	return report, nil
+}
+
+func (s *PullRequestService) reassignOne(ctx context.Context, tx pgx.Tx, a domain.OpenAssignment, userID string, reason domain.DeclineReason, note string, picked []string) (domain.BulkReassignResult, error) {
+	result := domain.BulkReassignResult{PullRequestID: a.PullRequestID}
+	if a.Kind != domain.AssignmentKindRegular {
+		result.Status = domain.BulkReassignSkipped
+		result.Reason = "shadow assignments are not reassigned"
+		return result, nil
+	}
+
+	pr, err := s.repo.GetPullRequestTx(ctx, tx, a.PullRequestID, false)
+	if errors.Is(err, repository.ErrPullRequestNotFound) {
+		result.Status = domain.BulkReassignSkipped
+		result.Reason = ErrPullRequestNotFound.Error()
+		return result, nil
+	}
+	if err != nil {
+		return domain.BulkReassignResult{}, err
+	}
+
+	replacement, err := s.pickReplacement(ctx, pr, userID, picked...)
+	if errors.Is(err, ErrNoCandidate) && len(picked) > 0 {
+		replacement, err = s.pickReplacement(ctx, pr, userID)
+	}
+	switch {
+	case errors.Is(err, ErrNoCandidate), errors.Is(err, ErrQuorumUnsatisfied):
+		result.Status = domain.BulkReassignNoCandidate
+		result.Reason = err.Error()
+		return result, nil
+	case errors.Is(err, ErrPullRequestMerged), errors.Is(err, ErrReviewerNotAssigned):
+		result.Status = domain.BulkReassignSkipped
+		result.Reason = err.Error()
+		return result, nil
+	case err != nil:
+		return domain.BulkReassignResult{}, err
+	}
+
+	err = s.repo.ReplaceReviewer(ctx, tx, a.PullRequestID, userID, replacement, note)
+	if errors.Is(err, repository.ErrReviewerNotAssigned) {
+		result.Status = domain.BulkReassignSkipped
+		result.Reason = ErrReviewerNotAssigned.Error()
+		return result, nil
+	}
+	if errors.Is(err, repository.ErrReviewerAlreadyAssigned) {
+		return domain.BulkReassignResult{}, ErrReviewerAlreadyAssigned
+	}
+	if err != nil {
+		return domain.BulkReassignResult{}, err
+	}
+	if err := s.repo.RecordReviewerDecline(ctx, tx, domain.ReviewerDecline{
+		PullRequestID: a.PullRequestID,
+		ReviewerID:    userID,
+		Reason:        reason,
+		Note:          note,
+		ReplacedBy:    replacement,
+		DeclinedAt:    s.now().UTC(),
+	}); err != nil {
+		return domain.BulkReassignResult{}, err
+	}
+
+	result.Status = domain.BulkReassignReassigned
+	result.ReplacedBy = replacement
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+var errReassignAborted = errors.New("concurrent reassign was aborted")
+
+type reassignDedupe struct {
+	mu    sync.Mutex
+	calls map[string]*reassignCall
+}
+
+type reassignCall struct {
+	done        chan struct{}
+	pr          domain.PullRequest
+	replacement string
+	err         error
+	finishedAt  time.Time
+}
+
+func (d *reassignDedupe) do(now func() time.Time, window time.Duration, key string, fn func() (domain.PullRequest, string, error)) (domain.PullRequest, string, error) {
+	if window <= 0 {
+		return fn()
+	}
+
+	d.mu.Lock()
+	if d.calls == nil {
+		d.calls = make(map[string]*reassignCall)
+	}
+	for k, c := range d.calls {
+		if !c.finishedAt.IsZero() && now().Sub(c.finishedAt) >= window {
+			delete(d.calls, k)
+		}
+	}
+	if call, ok := d.calls[key]; ok {
+		d.mu.Unlock()
+		<-call.done
+		return call.pr, call.replacement, call.err
+	}
+	call := &reassignCall{done: make(chan struct{})}
+	d.calls[key] = call
+	d.mu.Unlock()
+
+	call.err = errReassignAborted
+	defer func() {
+		d.mu.Lock()
+		if call.err != nil {
+			delete(d.calls, key)
+		} else {
+			call.finishedAt = now()
+		}
+		d.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.pr, call.replacement, call.err = fn()
+	return call.pr, call.replacement, call.err
+}
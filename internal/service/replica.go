@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *AdminService) GetReplicaRole(ctx context.Context, region string) (string, error) {
+	return s.repo.GetReplicaRole(ctx, region)
+}
+
+func (s *AdminService) SaveReplicaRole(ctx context.Context, region, role string) error {
+	return s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.SetReplicaRole(ctx, tx, region, role)
+	})
+}
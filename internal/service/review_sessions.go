@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *UserService) SuggestReviewSession(ctx context.Context, userID string, lineBudget int) (domain.ReviewSession, error) {
+	userID = domain.NormalizeID(userID)
+	lineBudget, err := domain.NewSessionLineBudget(lineBudget)
+	if err != nil {
+		return domain.ReviewSession{}, err
+	}
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.ReviewSession{}, ErrUserNotFound
+		}
+		return domain.ReviewSession{}, err
+	}
+
+	now := s.now().UTC()
+	candidates, err := s.repo.ListReviewSessionCandidates(ctx, userID, now)
+	if err != nil {
+		return domain.ReviewSession{}, err
+	}
+	items := domain.PlanReviewSession(candidates, lineBudget)
+	if len(items) == 0 {
+		return domain.ReviewSession{}, ErrNoPendingReviews
+	}
+
+	session := domain.ReviewSession{
+		ID:         s.ids.NewID(),
+		ReviewerID: userID,
+		Status:     domain.ReviewSessionSuggested,
+		LineBudget: lineBudget,
+		Items:      items,
+		CreatedAt:  now,
+	}
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.CreateReviewSession(ctx, tx, session)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.ReviewSession{}, ErrUserNotFound
+		}
+		return domain.ReviewSession{}, err
+	}
+
+	return session, nil
+}
+
+func (s *UserService) GetReviewSession(ctx context.Context, sessionID string) (domain.ReviewSession, error) {
+	session, err := s.repo.GetReviewSession(ctx, domain.NormalizeID(sessionID))
+	if errors.Is(err, repository.ErrReviewSessionNotFound) {
+		return domain.ReviewSession{}, ErrReviewSessionNotFound
+	}
+	return session, err
+}
+
+func (s *UserService) AdvanceReviewSession(ctx context.Context, sessionID string, status domain.ReviewSessionStatus) (domain.ReviewSession, []string, error) {
+	sessionID = domain.NormalizeID(sessionID)
+
+	var updated []string
+	err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		session, err := s.repo.GetReviewSessionForUpdate(ctx, tx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session.Status == domain.ReviewSessionCompleted {
+			return ErrReviewSessionCompleted
+		}
+		updated, err = s.repo.UpdateReviewSessionStatus(ctx, tx, sessionID, status, s.now().UTC())
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrReviewSessionNotFound) {
+			return domain.ReviewSession{}, nil, ErrReviewSessionNotFound
+		}
+		return domain.ReviewSession{}, nil, err
+	}
+	s.pullRequests.invalidate(updated...)
+
+	session, err := s.GetReviewSession(ctx, sessionID)
+	if err != nil {
+		return domain.ReviewSession{}, nil, err
+	}
+
+	var skipped []string
+	for _, item := range session.Items {
+		if !slices.Contains(updated, item.PullRequestID) {
+			skipped = append(skipped, item.PullRequestID)
+		}
+	}
+	return session, skipped, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *PullRequestService) ApproveReview(ctx context.Context, prID, reviewerID string) (domain.PullRequest, error) {
+	prID, reviewerID = domain.NormalizeID(prID), domain.NormalizeID(reviewerID)
+
+	pr, err := s.repo.GetPullRequest(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if pr.Status == domain.PullRequestStatusMerged {
+		return domain.PullRequest{}, ErrPullRequestMerged
+	}
+
+	now := s.now().UTC()
+	var updated domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.repo.ApproveReview(ctx, tx, prID, reviewerID, now); err != nil {
+			if errors.Is(err, repository.ErrReviewerNotAssigned) {
+				return ErrReviewerNotAssigned
+			}
+			return err
+		}
+		if err := s.repo.MarkReviewerResponded(ctx, tx, prID, reviewerID, now); err != nil {
+			return err
+		}
+		updated, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	s.pullRequests.invalidate(prID)
+
+	s.logger.Info("review approved",
+		zap.String("pull_request_id", prID),
+		zap.String("reviewer_id", reviewerID),
+		zap.Int("approvals", updated.Approvals()),
+	)
+	return updated, nil
+}
+
+func (s *PullRequestService) ensureApprovalsMet(ctx context.Context, pr domain.PullRequest) error {
+	author, err := s.repo.GetUser(ctx, pr.AuthorID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return err
+	}
+	var teamID int64
+	if author.TeamID != nil {
+		teamID = *author.TeamID
+	}
+
+	policies, err := s.resolvePolicies(ctx, teamID, pr.ID, nil)
+	if err != nil {
+		return err
+	}
+	required := policies.Get(domain.PolicyMinApprovals).Int()
+	if approvals := pr.Approvals(); !domain.ApprovalsMet(approvals, required, len(pr.Reviewers)) {
+		return fmt.Errorf("%w: requires %d approval(s), %d given", ErrApprovalsNotMet, min(required, len(pr.Reviewers)), approvals)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	DefaultRotationWeeks = 4
+	MaxRotationWeeks     = 52
+)
+
+func (s *TeamService) GetTeamRotation(ctx context.Context, teamName string, weeks int) (domain.TeamRotation, error) {
+	if weeks < 1 || weeks > MaxRotationWeeks {
+		return domain.TeamRotation{}, &domain.ValidationError{Field: "weeks", Message: "must be between 1 and 52"}
+	}
+
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.TeamRotation{}, err
+	}
+
+	rotation, err := s.repo.GetTeamRotation(ctx, team.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRotationNotFound) {
+			return domain.TeamRotation{}, ErrRotationNotFound
+		}
+		return domain.TeamRotation{}, err
+	}
+
+	return domain.TeamRotation{
+		TeamName: team.Name,
+		Rotation: rotation,
+		Schedule: rotation.Schedule(s.now(), weeks),
+	}, nil
+}
+
+func (s *TeamService) SetTeamRotation(ctx context.Context, teamName string, members []string, startsOn string) (domain.TeamRotation, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return domain.TeamRotation{}, err
+	}
+
+	if len(members) == 0 {
+		err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+			return s.repo.DeleteTeamRotation(ctx, tx, team.ID)
+		})
+		if err != nil {
+			return domain.TeamRotation{}, err
+		}
+		return domain.TeamRotation{TeamName: team.Name, Schedule: []domain.RotationWeek{}}, nil
+	}
+
+	rotation, err := domain.NewRotation(members, startsOn)
+	if err != nil {
+		return domain.TeamRotation{}, err
+	}
+	inTeam := make(map[string]bool, len(team.Members))
+	for _, m := range team.Members {
+		inTeam[m.UserID] = true
+	}
+	userIDs := make([]string, 0, len(rotation.Members))
+	for _, m := range rotation.Members {
+		if !inTeam[m.UserID] {
+			return domain.TeamRotation{}, &domain.ValidationError{Field: "members", Message: "user " + m.UserID + " is not a member of team " + team.Name}
+		}
+		userIDs = append(userIDs, m.UserID)
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.SetTeamRotation(ctx, tx, team.ID, userIDs, rotation.StartsOn)
+	})
+	if err != nil {
+		return domain.TeamRotation{}, err
+	}
+
+	return s.GetTeamRotation(ctx, team.Name, DefaultRotationWeeks)
+}
+
+func (s *PullRequestService) onDutyReviewer(ctx context.Context, teamID int64, exclude []string) (domain.RotationMember, bool, error) {
+	rotation, err := s.repo.GetTeamRotation(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRotationNotFound) {
+			return domain.RotationMember{}, false, nil
+		}
+		return domain.RotationMember{}, false, err
+	}
+
+	member, ok := rotation.OnDutyAt(s.now(), func(userID string) bool {
+		for _, id := range exclude {
+			if id == userID {
+				return true
+			}
+		}
+		return false
+	})
+	return member, ok, nil
+}
@@ -1,254 +1,154 @@
 package service
 
 import (
-	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
 	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
-	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
 )
 
 var (
-	ErrTeamExists          = errors.New("team already exists")
-	ErrTeamNotFound        = errors.New("team not found")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrPullRequestExists   = errors.New("pull request already exists")
-	ErrPullRequestNotFound = errors.New("pull request not found")
-	ErrPullRequestMerged   = errors.New("pull request already merged")
-	ErrReviewerNotAssigned = errors.New("reviewer not assigned")
-	ErrNoCandidate         = errors.New("no active replacement candidate")
+	ErrTeamExists              = errors.New("team already exists")
+	ErrTeamNotFound            = errors.New("team not found")
+	ErrUserNotFound            = errors.New("user not found")
+	ErrUserExists              = errors.New("user already exists")
+	ErrPullRequestExists       = errors.New("pull request already exists")
+	ErrPullRequestNotFound     = errors.New("pull request not found")
+	ErrPullRequestMerged       = errors.New("pull request already merged")
+	ErrReviewerNotAssigned     = errors.New("reviewer not assigned")
+	ErrReviewerAlreadyAssigned = errors.New("reviewer already assigned")
+	ErrNoCandidate             = errors.New("no active replacement candidate")
+	ErrChecklistItemNotFound   = errors.New("checklist item not found")
+	ErrChecklistIncomplete     = errors.New("review checklist is not complete")
+	ErrInvalidSnooze           = errors.New("snooze time must be in the future")
+	ErrSnoozeBudgetExceeded    = errors.New("snooze budget for this pull request is exhausted")
+	ErrInvalidCalendar         = errors.New("invalid calendar")
+	ErrInvalidQuorum           = errors.New("invalid quorum policy")
+	ErrQuorumUnsatisfied       = errors.New("reviewer quorum cannot be satisfied")
+	ErrQuorumNotMet            = errors.New("reviewer quorum is not met")
+	ErrDependencyCycle         = errors.New("pull request dependency would create a cycle")
+	ErrInvalidPullRequestID    = errors.New("pull_request_id must be a UUID")
+	ErrDuplicatePullRequest    = errors.New("author already has an open pull request with this name")
+	ErrInvalidMergeTime        = errors.New("merged_at must not be in the future or before the pull request was created")
+	ErrNotPullRequestAuthor    = errors.New("only the author or a trusted caller can delete or restore a pull request")
+	ErrRotationNotFound        = errors.New("team rotation is not configured")
+	ErrExclusionNotFound       = errors.New("reviewer exclusion not found")
+	ErrAuthorRateLimited       = errors.New("author daily pull request limit reached")
+	ErrSyncCursorExpired       = errors.New("sync cursor is older than the retained change log; resync from the beginning")
+	ErrNoPendingReviews        = errors.New("no pending reviews to put into a session")
+	ErrReviewSessionNotFound   = errors.New("review session not found")
+	ErrReviewSessionCompleted  = errors.New("review session already completed")
+	ErrFreezeNotFound          = errors.New("assignment freeze not found or already over")
+	ErrApprovalsNotMet         = errors.New("approval quorum is not met")
+	ErrUserVersionConflict     = errors.New("user was modified concurrently; reload it and retry with the current version")
+	ErrPoolExhausted           = repository.ErrPoolExhausted
 )
 
-type Service struct {
-	repo *repository.Repository
-	now  func() time.Time
+var PIILogKeys = []string{
+	"primary_reviewers",
+	"shadow_reviewers",
+	"deleted_by",
+	"restored_by",
+	"reset_reviewers",
+	"deactivated",
+	"restored",
 }
 
-func New(repo *repository.Repository) *Service {
-	return &Service{
-		repo: repo,
-		now:  time.Now,
-	}
+type Config struct {
+	ShadowAssignment   bool
+	AssignmentStrategy domain.AssignmentStrategyKind
+	SnoozeBudget       time.Duration
+	DefaultCalendar    domain.Calendar
+	ReviewSLA          time.Duration
+	LongPollMaxWait    time.Duration
+	MinTeamMembers     int
+	MaxTeamMembers     int
+	MinTZOverlap       time.Duration
+	MinApprovals       int
+
+	ReassignDedupeWindow     time.Duration
+	RequireUUIDPullRequestID bool
+	RequireDeclineReason     bool
+	AuthorDailyPullRequests  int
+	MemberSnapshotTTL        time.Duration
+	PullRequestCacheTTL      time.Duration
+	SyncRetention            time.Duration
+	PIIMode                  string
+	PIIKey                   string
+
+	AbsenceSource AbsenceSource
+	ArchiveStore  ArchiveStore
+
+	Clock Clock
+	IDs   IDGenerator
 }
 
-func (s *Service) CreateTeam(ctx context.Context, teamName string, members []domain.TeamMember) (domain.Team, error) {
-	err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		teamID, err := s.repo.InsertTeam(ctx, tx, teamName)
-		if err != nil {
-			if errors.Is(err, repository.ErrTeamExists) {
-				return ErrTeamExists
-			}
-			return err
-		}
-
-		for _, member := range members {
-			user := domain.User{
-				ID:       member.UserID,
-				Username: member.Username,
-				IsActive: member.IsActive,
-			}
-			if _, err := s.repo.UpsertUser(ctx, tx, user); err != nil {
-				return err
-			}
-			if err := s.repo.UpsertMembership(ctx, tx, teamID, member.UserID); err != nil {
-				return err
-			}
-		}
-
-		return nil
-	})
-	if err != nil {
-		return domain.Team{}, err
-	}
-
-	team, err := s.repo.GetTeamByName(ctx, teamName)
-	if err != nil {
-		if errors.Is(err, repository.ErrTeamNotFound) {
-			return domain.Team{}, ErrTeamNotFound
-		}
-		return domain.Team{}, err
-	}
+type base struct {
+	repo   *repository.Repository
+	logger *zap.Logger
+	cfg    Config
+	now    func() time.Time
+	ids    IDGenerator
 
-	return team, nil
+	members      memberSnapshotCache
+	pullRequests pullRequestCache
+	shadows      shadowCounters
 }
 
-func (s *Service) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
-	team, err := s.repo.GetTeamByName(ctx, teamName)
-	if err != nil {
-		if errors.Is(err, repository.ErrTeamNotFound) {
-			return domain.Team{}, ErrTeamNotFound
-		}
-		return domain.Team{}, err
-	}
-	return team, nil
+type TeamService struct {
+	*base
 }
 
-func (s *Service) SetUserActivity(ctx context.Context, userID string, isActive bool) (domain.User, error) {
-	user, err := s.repo.SetUserActive(ctx, userID, isActive)
-	if err != nil {
-		if errors.Is(err, repository.ErrUserNotFound) {
-			return domain.User{}, ErrUserNotFound
-		}
-		return domain.User{}, err
-	}
-	return user, nil
+type UserService struct {
+	*base
 }
 
-func (s *Service) CreatePullRequest(ctx context.Context, prID, prName, authorID string) (domain.PullRequest, error) {
-	author, err := s.repo.GetUser(ctx, authorID)
-	if err != nil {
-		if errors.Is(err, repository.ErrUserNotFound) {
-			return domain.PullRequest{}, ErrUserNotFound
-		}
-		return domain.PullRequest{}, err
-	}
-	if author.TeamID == nil {
-		return domain.PullRequest{}, ErrTeamNotFound
-	}
-
-	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := s.repo.CreatePullRequest(ctx, tx, domain.PullRequest{
-			ID:       prID,
-			Name:     prName,
-			AuthorID: authorID,
-			Status:   domain.PullRequestStatusOpen,
-		})
-		if err != nil {
-			if errors.Is(err, repository.ErrPullRequestExists) {
-				return ErrPullRequestExists
-			}
-			return err
-		}
-
-		reviewers, err := s.repo.ListRandomActiveTeamMembers(ctx, *author.TeamID, []string{author.ID}, 2)
-		if err != nil {
-			return err
-		}
-
-		reviewerIDs := make([]string, 0, len(reviewers))
-		for _, reviewer := range reviewers {
-			reviewerIDs = append(reviewerIDs, reviewer.UserID)
-		}
-
-		if err := s.repo.AddReviewers(ctx, tx, prID, reviewerIDs); err != nil {
-			return err
-		}
-
-		return nil
-	})
-	if err != nil {
-		return domain.PullRequest{}, err
-	}
-
-	pr, err := s.repo.GetPullRequest(ctx, prID)
-	if err != nil {
-		if errors.Is(err, repository.ErrPullRequestNotFound) {
-			return domain.PullRequest{}, ErrPullRequestNotFound
-		}
-		return domain.PullRequest{}, err
-	}
-
-	return pr, nil
+type PullRequestService struct {
+	*base
+	reassigns reassignDedupe
 }
 
-func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (domain.PullRequest, string, error) {
-	pr, err := s.repo.GetPullRequest(ctx, prID)
-	if err != nil {
-		if errors.Is(err, repository.ErrPullRequestNotFound) {
-			return domain.PullRequest{}, "", ErrPullRequestNotFound
-		}
-		return domain.PullRequest{}, "", err
-	}
-	if pr.Status == domain.PullRequestStatusMerged {
-		return domain.PullRequest{}, "", ErrPullRequestMerged
-	}
-
-	found := false
-	for _, reviewer := range pr.Reviewers {
-		if reviewer == oldReviewerID {
-			found = true
-			break
-		}
-	}
-	if !found {
-		return domain.PullRequest{}, "", ErrReviewerNotAssigned
-	}
-
-	reviewerUser, err := s.repo.GetUser(ctx, oldReviewerID)
-	if err != nil {
-		if errors.Is(err, repository.ErrUserNotFound) {
-			return domain.PullRequest{}, "", ErrUserNotFound
-		}
-		return domain.PullRequest{}, "", err
-	}
-	if reviewerUser.TeamID == nil {
-		return domain.PullRequest{}, "", ErrNoCandidate
-	}
-
-	exclude := make([]string, 0, len(pr.Reviewers)+2)
-	exclude = append(exclude, pr.AuthorID)
-	exclude = append(exclude, pr.Reviewers...)
-
-	candidates, err := s.repo.ListRandomActiveTeamMembers(ctx, *reviewerUser.TeamID, exclude, 1)
-	if err != nil {
-		return domain.PullRequest{}, "", err
-	}
-	if len(candidates) == 0 {
-		return domain.PullRequest{}, "", ErrNoCandidate
-	}
-
-	replacement := candidates[0].UserID
-	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		if err := s.repo.ReplaceReviewer(ctx, tx, prID, oldReviewerID, replacement); err != nil {
-			if errors.Is(err, repository.ErrReviewerNotAssigned) {
-				return ErrReviewerNotAssigned
-			}
-			return err
-		}
-		return nil
-	})
-	if err != nil {
-		return domain.PullRequest{}, "", err
-	}
+type StatsService struct {
+	*base
+}
 
-	updated, err := s.repo.GetPullRequest(ctx, prID)
-	if err != nil {
-		return domain.PullRequest{}, "", err
-	}
+type AdminService struct {
+	*base
+	invariants    atomic.Pointer[domain.InvariantGauges]
+	announcements announcementCache
+	absenceSync   atomic.Pointer[domain.AbsenceSyncReport]
+}
 
-	return updated, replacement, nil
+type Service struct {
+	Teams        *TeamService
+	Users        *UserService
+	PullRequests *PullRequestService
+	Stats        *StatsService
+	Admin        *AdminService
 }
 
-func (s *Service) MergePullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
-	pr, err := s.repo.GetPullRequest(ctx, prID)
-	if err != nil {
-		if errors.Is(err, repository.ErrPullRequestNotFound) {
-			return domain.PullRequest{}, ErrPullRequestNotFound
-		}
-		return domain.PullRequest{}, err
+func New(repo *repository.Repository, logger *zap.Logger, cfg Config) *Service {
+	if cfg.Clock == nil {
+		cfg.Clock = systemClock{}
 	}
-	if pr.Status == domain.PullRequestStatusMerged {
-		return pr, nil
+	if cfg.IDs == nil {
+		cfg.IDs = uuidGenerator{}
 	}
-
-	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		if err := s.repo.MarkPullRequestMerged(ctx, tx, prID, s.now().UTC()); err != nil {
-			if errors.Is(err, repository.ErrPullRequestNotFound) {
-				return ErrPullRequestNotFound
-			}
-			return err
-		}
-		return nil
-	})
-	if err != nil {
-		return domain.PullRequest{}, err
+	shared := &base{
+		repo:   repo,
+		logger: logger,
+		cfg:    cfg,
+		now:    cfg.Clock.Now,
+		ids:    cfg.IDs,
+	}
+	return &Service{
+		Teams:        &TeamService{base: shared},
+		Users:        &UserService{base: shared},
+		PullRequests: &PullRequestService{base: shared},
+		Stats:        &StatsService{base: shared},
+		Admin:        &AdminService{base: shared},
 	}
-
-	return s.repo.GetPullRequest(ctx, prID)
-}
-
-func (s *Service) ListReviewerPullRequests(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
-	return s.repo.ListPullRequestsForReviewer(ctx, userID)
 }
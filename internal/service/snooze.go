@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *PullRequestService) SnoozeReview(ctx context.Context, prID, reviewerID string, until time.Time) (domain.PullRequest, error) {
+	now := s.now().UTC()
+	if !until.After(now) {
+		return domain.PullRequest{}, ErrInvalidSnooze
+	}
+
+	pr, err := s.repo.GetPullRequest(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if pr.Status == domain.PullRequestStatusMerged {
+		return domain.PullRequest{}, ErrPullRequestMerged
+	}
+
+	var assignment *domain.ReviewerAssignment
+	for i := range pr.Assignments {
+		if pr.Assignments[i].ReviewerID == reviewerID {
+			assignment = &pr.Assignments[i]
+			break
+		}
+	}
+	if assignment == nil {
+		return domain.PullRequest{}, ErrReviewerNotAssigned
+	}
+
+	from := now
+	if assignment.SnoozedUntil != nil && assignment.SnoozedUntil.After(from) {
+		from = *assignment.SnoozedUntil
+	}
+	spent := until.Sub(from)
+	if spent < 0 {
+		spent = 0
+	}
+	budget, err := s.snoozeBudget(ctx, pr)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if budget > 0 && assignment.SnoozeUsed+spent > budget {
+		return domain.PullRequest{}, ErrSnoozeBudgetExceeded
+	}
+
+	var updated domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.repo.SnoozeReviewer(ctx, tx, prID, reviewerID, until, spent); err != nil {
+			if errors.Is(err, repository.ErrReviewerNotAssigned) {
+				return ErrReviewerNotAssigned
+			}
+			return err
+		}
+		updated, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	s.pullRequests.invalidate(prID)
+
+	return updated, nil
+}
+
+func (s *PullRequestService) WakeSnoozedReviews(ctx context.Context) error {
+	woken, err := s.repo.WakeSnoozedReviewers(ctx, s.now().UTC())
+	if err != nil {
+		return err
+	}
+	if woken > 0 {
+		s.pullRequests.invalidateAll()
+		s.logger.Info("snoozed reviews woke up", zap.Int64("count", woken))
+	}
+	return nil
+}
+
+func (s *UserService) ListReviewQueue(ctx context.Context, userID string, hideBlocked bool, page domain.Page) ([]domain.PullRequestShort, error) {
+	return s.repo.ListReviewQueue(ctx, userID, s.now().UTC(), hideBlocked, page)
+}
+
+func (s *PullRequestService) snoozeBudget(ctx context.Context, pr domain.PullRequest) (time.Duration, error) {
+	var teamID int64
+	author, err := s.repo.GetUser(ctx, pr.AuthorID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return 0, err
+	}
+	if author.TeamID != nil {
+		teamID = *author.TeamID
+	}
+
+	policies, err := s.resolvePolicies(ctx, teamID, pr.ID, nil)
+	if err != nil {
+		return 0, err
+	}
+	return policies.Get(domain.PolicySnoozeBudget).Duration(), nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *PullRequestService) DeletePullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	prID = domain.NormalizeID(prID)
+	actorID := ctxutil.CallerFrom(ctx).UserID
+
+	pr, err := s.repo.GetPullRequest(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if err := authorizePullRequestOwner(ctx, pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	var deleted domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.repo.SoftDeletePullRequest(ctx, tx, prID, actorID, s.now().UTC()); err != nil {
+			if errors.Is(err, repository.ErrPullRequestNotFound) {
+				return ErrPullRequestNotFound
+			}
+			return err
+		}
+		deleted, err = s.repo.GetPullRequestTx(ctx, tx, prID, true)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	s.pullRequests.invalidate(prID)
+
+	ctxutil.Logger(ctx, s.logger).Info("pull request deleted",
+		zap.String("pull_request_id", prID),
+		zap.String("deleted_by", actorID),
+	)
+
+	return deleted, nil
+}
+
+func (s *PullRequestService) RestorePullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	prID = domain.NormalizeID(prID)
+	actorID := ctxutil.CallerFrom(ctx).UserID
+
+	pr, err := s.repo.GetPullRequestIncludingDeleted(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if pr.DeletedAt == nil {
+		return domain.PullRequest{}, ErrPullRequestNotFound
+	}
+	if err := authorizePullRequestOwner(ctx, pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	var restored domain.PullRequest
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.repo.RestorePullRequest(ctx, tx, prID); err != nil {
+			switch {
+			case errors.Is(err, repository.ErrPullRequestNotFound):
+				return ErrPullRequestNotFound
+			case errors.Is(err, repository.ErrDuplicatePullRequest):
+				return ErrDuplicatePullRequest
+			}
+			return err
+		}
+		restored, err = s.repo.GetPullRequestTx(ctx, tx, prID, false)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	s.pullRequests.invalidate(prID)
+
+	ctxutil.Logger(ctx, s.logger).Info("pull request restored",
+		zap.String("pull_request_id", prID),
+		zap.String("restored_by", actorID),
+	)
+
+	return restored, nil
+}
+
+func authorizePullRequestOwner(ctx context.Context, pr domain.PullRequest) error {
+	caller := ctxutil.CallerFrom(ctx)
+	if !caller.Trusted || (caller.UserID != "" && caller.UserID != pr.AuthorID) {
+		return ErrNotPullRequestAuthor
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (s *StatsService) GetFirstResponseStats(ctx context.Context, teamName string) ([]domain.FirstResponseStats, error) {
+	var teamID *int64
+	if teamName != "" {
+		team, err := s.getTeam(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+		teamID = &team.ID
+	}
+
+	return s.repo.ListFirstResponseStats(ctx, teamID)
+}
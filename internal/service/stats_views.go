@@ -0,0 +1,7 @@
+package service
+
+import "context"
+
+func (s *StatsService) RefreshStatsViews(ctx context.Context) error {
+	return s.repo.RefreshStatsViews(ctx)
+}
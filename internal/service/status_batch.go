@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (s *PullRequestService) GetPullRequestStatuses(ctx context.Context, prIDs []string) ([]domain.PullRequestStatusSummary, []string, error) {
+	ids := make([]string, 0, len(prIDs))
+	seen := make(map[string]bool, len(prIDs))
+	for _, id := range prIDs {
+		id = domain.NormalizeID(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	found, err := s.repo.ListPullRequestStatuses(ctx, ids, s.cfg.MinApprovals)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[string]domain.PullRequestStatusSummary, len(found))
+	for _, summary := range found {
+		byID[summary.ID] = summary
+	}
+
+	summaries := make([]domain.PullRequestStatusSummary, 0, len(byID))
+	notFound := []string{}
+	for _, id := range ids {
+		summary, ok := byID[id]
+		if !ok {
+			notFound = append(notFound, id)
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, notFound, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"go.uber.org/zap"
+)
+
+func (s *AdminService) ListChanges(ctx context.Context, after domain.SyncCursor, limit int) (domain.SyncPage, error) {
+	if after.Legacy() {
+		return domain.SyncPage{}, ErrSyncCursorExpired
+	}
+	if !after.IsZero() {
+		purged, err := s.repo.SyncPurgedThrough(ctx)
+		if err != nil {
+			return domain.SyncPage{}, err
+		}
+		if after.Compare(purged) < 0 {
+			return domain.SyncPage{}, ErrSyncCursorExpired
+		}
+	}
+
+	return s.repo.ListSyncChanges(ctx, after, limit)
+}
+
+func (s *AdminService) CompactSyncChanges(ctx context.Context) error {
+	removed, err := s.repo.CompactSyncChanges(ctx, s.now().Add(-s.cfg.SyncRetention))
+	if err != nil {
+		return err
+	}
+	if removed > 0 {
+		s.logger.Info("sync change log compacted", zap.Int64("removed", removed))
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *TeamService) CreateTeam(ctx context.Context, teamName string, members []domain.TeamMember, reviewersRequired *int) (domain.Team, error) {
+	desired, err := domain.NewTeam(teamName, members)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if err := s.validateTeamSize(desired); err != nil {
+		return domain.Team{}, err
+	}
+	teamName, members = desired.Name, desired.Members
+
+	var reviewers *domain.PolicyOverride
+	if reviewersRequired != nil {
+		override, err := teamReviewersOverride(teamName, reviewersRequired)
+		if err != nil {
+			return domain.Team{}, err
+		}
+		reviewers = &override
+	}
+
+	var team domain.Team
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := s.repo.LockTeamByName(ctx, tx, teamName)
+		switch {
+		case err == nil:
+			return ErrTeamExists
+		case !errors.Is(err, repository.ErrTeamNotFound):
+			return err
+		}
+
+		team, err = s.repo.InsertTeam(ctx, tx, teamName)
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamExists) {
+				return ErrTeamExists
+			}
+			return err
+		}
+
+		for _, member := range members {
+			user := domain.User{
+				ID:        member.UserID,
+				Username:  member.Username,
+				IsActive:  member.IsActive,
+				Seniority: member.Seniority,
+			}
+			stored, err := s.repo.UpsertUser(ctx, tx, user)
+			if err != nil {
+				return err
+			}
+			if err := s.repo.UpsertMembership(ctx, tx, team.ID, member.UserID); err != nil {
+				return err
+			}
+			team.Members = append(team.Members, domain.TeamMember{
+				UserID:    stored.ID,
+				Username:  stored.Username,
+				IsActive:  stored.IsActive,
+				Seniority: stored.Seniority,
+			})
+		}
+
+		if reviewers != nil {
+			return s.repo.UpsertPolicyOverride(ctx, tx, *reviewers, &team.ID, s.now().UTC())
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Team{}, err
+	}
+	s.members.invalidate()
+
+	slices.SortStableFunc(team.Members, func(a, b domain.TeamMember) int {
+		return strings.Compare(a.Username, b.Username)
+	})
+	return team, nil
+}
+
+func (s *TeamService) UpsertTeam(ctx context.Context, teamName string, members []domain.TeamMember) (domain.Team, domain.TeamPlan, error) {
+	desired, err := domain.NewTeam(teamName, members)
+	if err != nil {
+		return domain.Team{}, domain.TeamPlan{}, err
+	}
+	if err := s.validateTeamSize(desired); err != nil {
+		return domain.Team{}, domain.TeamPlan{}, err
+	}
+
+	var apply teamApply
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		current, err := s.repo.LockTeamByName(ctx, tx, desired.Name)
+		if err != nil && !errors.Is(err, repository.ErrTeamNotFound) {
+			return err
+		}
+		apply = planTeam(desired, current, err == nil)
+		return s.applyTeam(ctx, tx, apply)
+	})
+	if err != nil {
+		return domain.Team{}, domain.TeamPlan{}, err
+	}
+	s.members.invalidate()
+
+	team, err := s.getTeam(ctx, desired.Name)
+	if err != nil {
+		return domain.Team{}, domain.TeamPlan{}, err
+	}
+
+	return team, apply.plan, nil
+}
+
+func (s *base) validateTeamSize(team domain.Team) error {
+	switch {
+	case len(team.Members) < s.cfg.MinTeamMembers:
+		return &domain.ValidationError{
+			Field:   "members",
+			Message: fmt.Sprintf("team %s must have at least %d members, got %d", team.Name, s.cfg.MinTeamMembers, len(team.Members)),
+		}
+	case s.cfg.MaxTeamMembers > 0 && len(team.Members) > s.cfg.MaxTeamMembers:
+		return &domain.ValidationError{
+			Field:   "members",
+			Message: fmt.Sprintf("team %s must have at most %d members, got %d", team.Name, s.cfg.MaxTeamMembers, len(team.Members)),
+		}
+	}
+	return nil
+}
+
+type teamApply struct {
+	desired domain.Team
+	teamID  int64
+	plan    domain.TeamPlan
+}
+
+func (s *TeamService) ApplyTeams(ctx context.Context, teams []domain.Team, dryRun bool) ([]domain.TeamPlan, error) {
+	desiredTeams := make([]domain.Team, 0, len(teams))
+	for _, team := range teams {
+		desired, err := domain.NewTeam(team.Name, team.Members)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.validateTeamSize(desired); err != nil {
+			return nil, err
+		}
+		desiredTeams = append(desiredTeams, desired)
+	}
+
+	if dryRun {
+		plans := make([]domain.TeamPlan, 0, len(desiredTeams))
+		for _, desired := range desiredTeams {
+			current, err := s.repo.GetTeamByName(ctx, desired.Name)
+			if err != nil && !errors.Is(err, repository.ErrTeamNotFound) {
+				return nil, err
+			}
+			plans = append(plans, planTeam(desired, current, err == nil).plan)
+		}
+		return plans, nil
+	}
+
+	var plans []domain.TeamPlan
+	err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		locked := slices.SortedFunc(slices.Values(desiredTeams), func(a, b domain.Team) int {
+			return strings.Compare(domain.TeamNameKey(a.Name), domain.TeamNameKey(b.Name))
+		})
+		current := make(map[string]domain.Team, len(locked))
+		for _, desired := range locked {
+			team, err := s.repo.LockTeamByName(ctx, tx, desired.Name)
+			if err != nil && !errors.Is(err, repository.ErrTeamNotFound) {
+				return err
+			}
+			if err == nil {
+				current[domain.TeamNameKey(desired.Name)] = team
+			}
+		}
+
+		plans = make([]domain.TeamPlan, 0, len(desiredTeams))
+		for _, desired := range desiredTeams {
+			team, ok := current[domain.TeamNameKey(desired.Name)]
+			apply := planTeam(desired, team, ok)
+			if err := s.applyTeam(ctx, tx, apply); err != nil {
+				return err
+			}
+			plans = append(plans, apply.plan)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.members.invalidate()
+
+	return plans, nil
+}
+
+func planTeam(desired, current domain.Team, exists bool) teamApply {
+	apply := teamApply{
+		desired: desired,
+		plan: domain.TeamPlan{
+			TeamName:       desired.Name,
+			AddedMembers:   []string{},
+			UpdatedMembers: []string{},
+			RemovedMembers: []string{},
+		},
+	}
+
+	if !exists {
+		apply.plan.Action = domain.TeamApplyActionCreate
+		for _, member := range desired.Members {
+			apply.plan.AddedMembers = append(apply.plan.AddedMembers, member.UserID)
+		}
+		return apply
+	}
+	apply.teamID = current.ID
+
+	existing := make(map[string]domain.TeamMember, len(current.Members))
+	for _, member := range current.Members {
+		existing[member.UserID] = member
+	}
+
+	for _, member := range desired.Members {
+		old, ok := existing[member.UserID]
+		switch {
+		case !ok:
+			apply.plan.AddedMembers = append(apply.plan.AddedMembers, member.UserID)
+		case old.Username != member.Username || old.IsActive != member.IsActive,
+			member.Seniority != "" && old.Seniority != member.Seniority:
+			apply.plan.UpdatedMembers = append(apply.plan.UpdatedMembers, member.UserID)
+		}
+		delete(existing, member.UserID)
+	}
+	for _, member := range current.Members {
+		if _, ok := existing[member.UserID]; ok {
+			apply.plan.RemovedMembers = append(apply.plan.RemovedMembers, member.UserID)
+		}
+	}
+
+	apply.plan.Action = domain.TeamApplyActionNoop
+	if len(apply.plan.AddedMembers)+len(apply.plan.UpdatedMembers)+len(apply.plan.RemovedMembers) > 0 {
+		apply.plan.Action = domain.TeamApplyActionUpdate
+	}
+
+	return apply
+}
+
+func (s *TeamService) applyTeam(ctx context.Context, tx pgx.Tx, apply teamApply) error {
+	if apply.plan.Action == domain.TeamApplyActionNoop {
+		return nil
+	}
+
+	teamID := apply.teamID
+	if apply.plan.Action == domain.TeamApplyActionCreate {
+		created, err := s.repo.InsertTeam(ctx, tx, apply.desired.Name)
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamExists) {
+				return ErrTeamExists
+			}
+			return err
+		}
+		teamID = created.ID
+	}
+
+	changed := make(map[string]bool, len(apply.plan.AddedMembers)+len(apply.plan.UpdatedMembers))
+	for _, userID := range apply.plan.AddedMembers {
+		changed[userID] = true
+	}
+	for _, userID := range apply.plan.UpdatedMembers {
+		changed[userID] = false
+	}
+
+	for _, member := range apply.desired.Members {
+		added, ok := changed[member.UserID]
+		if !ok {
+			continue
+		}
+		user := domain.User{
+			ID:        member.UserID,
+			Username:  member.Username,
+			IsActive:  member.IsActive,
+			Seniority: member.Seniority,
+		}
+		if _, err := s.repo.UpsertUser(ctx, tx, user); err != nil {
+			return err
+		}
+		if added {
+			if err := s.repo.UpsertMembership(ctx, tx, teamID, member.UserID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, userID := range apply.plan.RemovedMembers {
+		if err := s.repo.DeleteMembership(ctx, tx, teamID, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TeamService) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
+	return s.getTeam(ctx, teamName)
+}
+
+func (s *base) getTeam(ctx context.Context, teamName string) (domain.Team, error) {
+	team, err := s.repo.GetTeamByName(ctx, domain.NormalizeTeamName(teamName))
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return domain.Team{}, ErrTeamNotFound
+		}
+		return domain.Team{}, err
+	}
+	return team, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+)
+
+func (s *TeamService) GetTeamOverview(ctx context.Context, teamName string) (domain.Team, domain.TeamMemberCounts, error) {
+	team, err := s.repo.GetTeamSettings(ctx, domain.NormalizeTeamName(teamName))
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return domain.Team{}, domain.TeamMemberCounts{}, ErrTeamNotFound
+		}
+		return domain.Team{}, domain.TeamMemberCounts{}, err
+	}
+
+	counts, err := s.repo.CountTeamMembers(ctx, team.ID)
+	if err != nil {
+		return domain.Team{}, domain.TeamMemberCounts{}, err
+	}
+	return team, counts, nil
+}
+
+func (s *TeamService) ListTeamMembers(ctx context.Context, teamID int64, page domain.Page) ([]domain.TeamMember, error) {
+	return s.repo.ListTeamMembersPage(ctx, teamID, page)
+}
+
+func (s *TeamService) StreamTeamMembers(ctx context.Context, teamID int64, fn func(domain.TeamMember) error) error {
+	return s.repo.EachTeamMember(ctx, teamID, fn)
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+func (s *TeamService) SearchTeams(ctx context.Context, filter domain.TeamSearchFilter, page domain.Page) ([]domain.TeamSearchResult, error) {
+	filter, err := domain.NewTeamSearchFilter(filter.Query, filter.HasActiveMembers, filter.MinSize)
+	if err != nil {
+		return nil, err
+	}
+	if filter.Query == "" && page.SortBy == "relevance" {
+		page.SortBy, page.Desc = "name", false
+	}
+	return s.repo.SearchTeams(ctx, filter, page)
+}
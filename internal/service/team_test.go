@@ -0,0 +1,64 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/testfixtures"
+)
+
+func TestPlanTeam(t *testing.T) {
+	current := testfixtures.NewTeamBuilder().WithMembers(3).Build()
+	current.ID = 7
+
+	t.Run("create", func(t *testing.T) {
+		desired := testfixtures.NewTeamBuilder().WithMembers(2).Build()
+
+		apply := planTeam(desired, domain.Team{}, false)
+		if apply.plan.Action != domain.TeamApplyActionCreate {
+			t.Errorf("Action = %q, want create", apply.plan.Action)
+		}
+		if want := []string{"backend-u1", "backend-u2"}; !slices.Equal(apply.plan.AddedMembers, want) {
+			t.Errorf("AddedMembers = %v, want %v", apply.plan.AddedMembers, want)
+		}
+	})
+
+	t.Run("noop", func(t *testing.T) {
+		apply := planTeam(current, current, true)
+		if apply.plan.Action != domain.TeamApplyActionNoop {
+			t.Errorf("Action = %q, want noop", apply.plan.Action)
+		}
+		if apply.teamID != current.ID {
+			t.Errorf("teamID = %d, want %d", apply.teamID, current.ID)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		desired := testfixtures.NewTeamBuilder().WithMembers(4).Inactive(1).Build()
+		desired.Members = slices.Delete(desired.Members, 0, 1)
+
+		apply := planTeam(desired, current, true)
+		if apply.plan.Action != domain.TeamApplyActionUpdate {
+			t.Errorf("Action = %q, want update", apply.plan.Action)
+		}
+		if want := []string{"backend-u4"}; !slices.Equal(apply.plan.AddedMembers, want) {
+			t.Errorf("AddedMembers = %v, want %v", apply.plan.AddedMembers, want)
+		}
+		if want := []string{"backend-u1"}; !slices.Equal(apply.plan.RemovedMembers, want) {
+			t.Errorf("RemovedMembers = %v, want %v", apply.plan.RemovedMembers, want)
+		}
+		if len(apply.plan.UpdatedMembers) != 0 {
+			t.Errorf("UpdatedMembers = %v, want none", apply.plan.UpdatedMembers)
+		}
+	})
+
+	t.Run("seniority change is an update", func(t *testing.T) {
+		desired := testfixtures.NewTeamBuilder().WithMembers(3).Seniors(1).Build()
+
+		apply := planTeam(desired, current, true)
+		if want := []string{"backend-u1"}; !slices.Equal(apply.plan.UpdatedMembers, want) {
+			t.Errorf("UpdatedMembers = %v, want %v", apply.plan.UpdatedMembers, want)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+)
+
+func (s *PullRequestService) GetTimeline(ctx context.Context, prID string) (domain.PullRequestTimeline, error) {
+	prID = domain.NormalizeID(prID)
+	if _, err := s.repo.GetPullRequestIncludingDeleted(ctx, prID); err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return domain.PullRequestTimeline{}, ErrPullRequestNotFound
+		}
+		return domain.PullRequestTimeline{}, err
+	}
+
+	events, err := s.repo.ListPullRequestTimeline(ctx, prID)
+	if err != nil {
+		return domain.PullRequestTimeline{}, err
+	}
+
+	return domain.PullRequestTimeline{PullRequestID: prID, Events: events}, nil
+}
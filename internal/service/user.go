@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (s *UserService) SetUserActivity(ctx context.Context, userID string, isActive bool) (domain.User, error) {
+	user, err := s.repo.SetUserActive(ctx, domain.NormalizeID(userID), isActive)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.User{}, ErrUserNotFound
+		}
+		return domain.User{}, err
+	}
+	s.members.invalidate()
+	return user, nil
+}
+
+func (s *UserService) PatchUser(ctx context.Context, patch domain.UserPatch) (domain.User, domain.WorkingHours, error) {
+	var user domain.User
+	var hours domain.WorkingHours
+	err := s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var err error
+		user, hours, err = s.repo.PatchUser(ctx, tx, patch)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return domain.User{}, domain.WorkingHours{}, ErrUserNotFound
+		case errors.Is(err, repository.ErrUserVersionConflict):
+			return domain.User{}, domain.WorkingHours{}, ErrUserVersionConflict
+		}
+		return domain.User{}, domain.WorkingHours{}, err
+	}
+
+	s.members.invalidate()
+	fields := make([]string, len(patch.Mask))
+	for i, f := range patch.Mask {
+		fields[i] = string(f)
+	}
+	s.logger.Info("user updated",
+		zap.String("user_id", user.ID),
+		zap.Strings("fields", fields),
+		zap.Int64("version", user.Version),
+	)
+	return user, hours, nil
+}
+
+func (s *UserService) ListReviewerPullRequests(ctx context.Context, userID string, page domain.Page, includeDeleted bool) ([]domain.PullRequestShort, error) {
+	return s.repo.ListPullRequestsForReviewer(ctx, domain.NormalizeID(userID), page, includeDeleted)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *UserService) GetWorkingHours(ctx context.Context, userID string) (domain.WorkingHours, error) {
+	hours, err := s.repo.GetWorkingHours(ctx, domain.NormalizeID(userID))
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return domain.WorkingHours{}, ErrUserNotFound
+	}
+	return hours, err
+}
+
+func (s *UserService) SetWorkingHours(ctx context.Context, userID, timezone string, start, end time.Duration) (domain.WorkingHours, error) {
+	hours, err := domain.NewWorkingHours(userID, timezone, start, end)
+	if err != nil {
+		return domain.WorkingHours{}, err
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return s.repo.SetWorkingHours(ctx, tx, hours)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.WorkingHours{}, ErrUserNotFound
+		}
+		return domain.WorkingHours{}, err
+	}
+
+	s.members.invalidate()
+	return hours, nil
+}
+
+func (s *base) overlapPreference(ctx context.Context, authorID string, policies domain.PolicyResolution) (func(domain.TeamMember) bool, error) {
+	minOverlap := policies.Get(domain.PolicyMinTZOverlap).Duration()
+	if minOverlap <= 0 || authorID == "" {
+		return nil, nil
+	}
+
+	author, err := s.repo.GetWorkingHours(ctx, authorID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !author.Known() {
+		return nil, nil
+	}
+
+	now := s.now()
+	return func(m domain.TeamMember) bool {
+		return !m.WorkingHours.Known() || author.Overlap(m.WorkingHours, now) >= minOverlap
+	}, nil
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const acquireSampleInterval = time.Second
+
+type AcquireMonitor struct {
+	pool *pgxpool.Pool
+
+	mu        sync.Mutex
+	sampledAt time.Time
+	count     int64
+	duration  time.Duration
+	latency   time.Duration
+}
+
+func NewAcquireMonitor(pool *pgxpool.Pool) *AcquireMonitor {
+	return &AcquireMonitor{pool: pool}
+}
+
+func (m *AcquireMonitor) Latency() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(m.sampledAt) < acquireSampleInterval {
+		return m.latency
+	}
+
+	stat := m.pool.Stat()
+	count, duration := stat.AcquireCount(), stat.AcquireDuration()
+	if delta := count - m.count; delta > 0 {
+		m.latency = (duration - m.duration) / time.Duration(delta)
+	} else {
+		m.latency = 0
+	}
+	m.sampledAt, m.count, m.duration = now, count, duration
+
+	return m.latency
+}
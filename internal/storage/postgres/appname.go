@@ -0,0 +1,19 @@
+package postgres
+
+const (
+	applicationName       = "pr-reviewer"
+	maxApplicationNameLen = 63
+)
+
+// componentApplicationName is the application_name a pool sends in its startup
+// packet. It is fixed per pool, so connections never change it after connect.
+func componentApplicationName(component string) string {
+	name := applicationName
+	if component != "" {
+		name += "/" + component
+	}
+	if len(name) > maxApplicationNameLen {
+		name = name[:maxApplicationNameLen]
+	}
+	return name
+}
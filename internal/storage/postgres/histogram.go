@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	maxQueryNames  = 200
+	otherQueryName = "other"
+)
+
+var QueryDurationBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type QueryDurations struct {
+	Name    string
+	Buckets []int64
+	Count   int64
+	Sum     time.Duration
+}
+
+type QueryHistogram struct {
+	mu      sync.Mutex
+	queries map[string]*QueryDurations
+}
+
+func NewQueryHistogram() *QueryHistogram {
+	return &QueryHistogram{queries: make(map[string]*QueryDurations)}
+}
+
+func (h *QueryHistogram) observe(name string, elapsed time.Duration) {
+	seconds := elapsed.Seconds()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	q, ok := h.queries[name]
+	if !ok {
+		if len(h.queries) >= maxQueryNames {
+			name = otherQueryName
+		}
+		if q, ok = h.queries[name]; !ok {
+			q = &QueryDurations{Name: name, Buckets: make([]int64, len(QueryDurationBuckets))}
+			h.queries[name] = q
+		}
+	}
+	for i, bound := range QueryDurationBuckets {
+		if seconds <= bound {
+			q.Buckets[i]++
+		}
+	}
+	q.Count++
+	q.Sum += elapsed
+}
+
+func (h *QueryHistogram) Snapshot() []QueryDurations {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	result := make([]QueryDurations, 0, len(h.queries))
+	for _, q := range h.queries {
+		snapshot := *q
+		snapshot.Buckets = slices.Clone(q.Buckets)
+		result = append(result, snapshot)
+	}
+	slices.SortFunc(result, func(a, b QueryDurations) int { return strings.Compare(a.Name, b.Name) })
+	return result
+}
+
+func queryName(sql string) string {
+	fields := strings.Fields(strings.ToLower(sql))
+	if len(fields) == 0 {
+		return "unknown"
+	}
+
+	op := fields[0]
+	for i := 0; i < len(fields)-1; i++ {
+		switch fields[i] {
+		case "from", "into", "update", "join":
+			table, _, _ := strings.Cut(strings.TrimLeft(fields[i+1], "("), "(")
+			table = strings.TrimRight(table, ",;)")
+			if table != "" && table != "select" && table != "lateral" && !strings.HasPrefix(table, "$") {
+				return op + " " + table
+			}
+		}
+	}
+	return op
+}
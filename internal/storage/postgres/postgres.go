@@ -5,15 +5,47 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
 
-func New(ctx context.Context, dsn string, logger *zap.Logger) (*pgxpool.Pool, error) {
+type Options struct {
+	// Component is appended to application_name ("pr-reviewer/<component>")
+	// unless the DSN sets application_name itself.
+	Component          string
+	RefreshDSN         func(context.Context) (string, error)
+	SlowQueryThreshold time.Duration
+	QueryHistogram     *QueryHistogram
+}
+
+func New(ctx context.Context, dsn string, logger *zap.Logger, opts Options) (*pgxpool.Pool, error) {
 	cfg, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("parse postgres config: %w", err)
 	}
+	if refreshDSN := opts.RefreshDSN; refreshDSN != nil {
+		cfg.BeforeConnect = func(ctx context.Context, connCfg *pgx.ConnConfig) error {
+			current, err := refreshDSN(ctx)
+			if err != nil {
+				return fmt.Errorf("refresh postgres credentials: %w", err)
+			}
+			fresh, err := pgx.ParseConfig(current)
+			if err != nil {
+				return fmt.Errorf("parse refreshed postgres config: %w", err)
+			}
+			connCfg.User = fresh.User
+			connCfg.Password = fresh.Password
+			return nil
+		}
+	}
+
+	if _, ok := cfg.ConnConfig.RuntimeParams["application_name"]; !ok {
+		cfg.ConnConfig.RuntimeParams["application_name"] = componentApplicationName(opts.Component)
+	}
+	if (logger != nil && opts.SlowQueryThreshold > 0) || opts.QueryHistogram != nil {
+		cfg.ConnConfig.Tracer = &slowQueryTracer{threshold: opts.SlowQueryThreshold, logger: logger, histogram: opts.QueryHistogram}
+	}
 
 	pool, err := pgxpool.NewWithConfig(ctx, cfg)
 	if err != nil {
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/ctxutil"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const maxLoggedSQLLen = 1000
+
+type slowQueryTracer struct {
+	threshold time.Duration
+	logger    *zap.Logger
+	histogram *QueryHistogram
+}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	sql   string
+	args  int
+	start time.Time
+}
+
+func (t *slowQueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryTraceKey{}, queryTrace{
+		sql:   data.SQL,
+		args:  len(data.Args),
+		start: time.Now(),
+	})
+}
+
+func (t *slowQueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(queryTrace)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(trace.start)
+	if t.histogram != nil {
+		t.histogram.observe(queryName(trace.sql), elapsed)
+	}
+	if t.logger == nil || t.threshold <= 0 || elapsed < t.threshold {
+		return
+	}
+
+	fields := []zap.Field{
+		zap.Duration("duration", elapsed),
+		zap.String("sql", sanitizeSQL(trace.sql)),
+		zap.Int("args", trace.args),
+		zap.String("request_id", ctxutil.RequestID(ctx)),
+	}
+	if data.Err != nil {
+		fields = append(fields, zap.Error(data.Err))
+	}
+	t.logger.Warn("slow query", fields...)
+}
+
+func sanitizeSQL(sql string) string {
+	sql = strings.Join(strings.Fields(sql), " ")
+	if len(sql) > maxLoggedSQLLen {
+		sql = sql[:maxLoggedSQLLen] + "..."
+	}
+	return sql
+}
@@ -0,0 +1,84 @@
+package testfixtures
+
+import (
+	"time"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+var BaseTime = time.Date(2025, time.October, 1, 10, 0, 0, 0, time.UTC)
+
+type PullRequestBuilder struct {
+	pr domain.PullRequest
+}
+
+func NewPullRequestBuilder() *PullRequestBuilder {
+	return &PullRequestBuilder{pr: domain.PullRequest{
+		ID:        "pr-1",
+		Name:      "Add feature",
+		AuthorID:  "backend-u1",
+		Status:    domain.PullRequestStatusOpen,
+		CreatedAt: BaseTime,
+	}}
+}
+
+func (b *PullRequestBuilder) WithID(id string) *PullRequestBuilder {
+	b.pr.ID = id
+	return b
+}
+
+func (b *PullRequestBuilder) WithName(name string) *PullRequestBuilder {
+	b.pr.Name = name
+	return b
+}
+
+func (b *PullRequestBuilder) WithAuthor(authorID string) *PullRequestBuilder {
+	b.pr.AuthorID = authorID
+	return b
+}
+
+func (b *PullRequestBuilder) WithReviewers(reviewerIDs ...string) *PullRequestBuilder {
+	for _, id := range reviewerIDs {
+		b.pr.Reviewers = append(b.pr.Reviewers, id)
+		b.pr.Assignments = append(b.pr.Assignments, domain.ReviewerAssignment{
+			ReviewerID: id,
+			Kind:       domain.AssignmentKindRegular,
+			AssignedAt: b.pr.CreatedAt,
+		})
+	}
+	return b
+}
+
+func (b *PullRequestBuilder) WithShadows(reviewerIDs ...string) *PullRequestBuilder {
+	for _, id := range reviewerIDs {
+		b.pr.Shadows = append(b.pr.Shadows, id)
+		b.pr.Assignments = append(b.pr.Assignments, domain.ReviewerAssignment{
+			ReviewerID: id,
+			Kind:       domain.AssignmentKindShadow,
+			AssignedAt: b.pr.CreatedAt,
+		})
+	}
+	return b
+}
+
+func (b *PullRequestBuilder) CreatedAt(at time.Time) *PullRequestBuilder {
+	b.pr.CreatedAt = at
+	return b
+}
+
+func (b *PullRequestBuilder) Merged(at time.Time, by string) *PullRequestBuilder {
+	b.pr.Status = domain.PullRequestStatusMerged
+	b.pr.MergedAt = &at
+	if by != "" {
+		b.pr.MergedBy = &by
+	}
+	return b
+}
+
+func (b *PullRequestBuilder) Build() domain.PullRequest {
+	pr := b.pr
+	pr.Reviewers = append([]string{}, b.pr.Reviewers...)
+	pr.Shadows = append([]string{}, b.pr.Shadows...)
+	pr.Assignments = append([]domain.ReviewerAssignment(nil), b.pr.Assignments...)
+	return pr
+}
@@ -0,0 +1,71 @@
+package testfixtures
+
+import (
+	"fmt"
+
+	"github.com/bubelovv/avito-internship-autumn-2025/internal/domain"
+)
+
+type TeamBuilder struct {
+	team     domain.Team
+	members  int
+	inactive int
+	senior   int
+	junior   int
+}
+
+func NewTeamBuilder() *TeamBuilder {
+	return &TeamBuilder{team: domain.Team{Name: "backend"}}
+}
+
+func (b *TeamBuilder) Named(name string) *TeamBuilder {
+	b.team.Name = name
+	return b
+}
+
+func (b *TeamBuilder) WithMembers(n int) *TeamBuilder {
+	b.members = n
+	return b
+}
+
+func (b *TeamBuilder) Inactive(n int) *TeamBuilder {
+	b.inactive = n
+	return b
+}
+
+func (b *TeamBuilder) Seniors(n int) *TeamBuilder {
+	b.senior = n
+	return b
+}
+
+func (b *TeamBuilder) Juniors(n int) *TeamBuilder {
+	b.junior = n
+	return b
+}
+
+func (b *TeamBuilder) WithQuorum(seniority domain.Seniority, minReviewers int) *TeamBuilder {
+	b.team.Quorum = append(b.team.Quorum, domain.QuorumRule{Seniority: seniority, MinReviewers: minReviewers})
+	return b
+}
+
+func (b *TeamBuilder) Build() domain.Team {
+	team := b.team
+	team.Quorum = append([]domain.QuorumRule(nil), b.team.Quorum...)
+	team.Members = make([]domain.TeamMember, 0, b.members)
+	for i := range b.members {
+		seniority := domain.SeniorityMiddle
+		switch {
+		case i < b.senior:
+			seniority = domain.SenioritySenior
+		case i < b.senior+b.junior:
+			seniority = domain.SeniorityJunior
+		}
+		team.Members = append(team.Members, domain.TeamMember{
+			UserID:    fmt.Sprintf("%s-u%d", team.Name, i+1),
+			Username:  fmt.Sprintf("%s user %d", team.Name, i+1),
+			IsActive:  i < b.members-b.inactive,
+			Seniority: seniority,
+		})
+	}
+	return team
+}
@@ -0,0 +1,7 @@
+// Package openapi embeds openapi.yaml, the published contract of the HTTP API.
+package openapi
+
+import _ "embed"
+
+//go:embed openapi.yaml
+var Spec []byte